@@ -0,0 +1,25 @@
+resource "core-v1_cr" "leaf-1-ethernet-1-3" {
+  api_version = "interfaces.eda.nokia.com/v1alpha1"
+  kind        = "Interface"
+  metadata = {
+    labels = {
+      "eda.nokia.com/role" = "interSwitch"
+    }
+    name      = "leaf-1-ethernet-1-3"
+    namespace = "eda"
+  }
+  spec = {
+    description = "generated from terraform"
+    enabled     = true
+    lldp        = true
+    members = [
+      {
+        enabled          = true
+        interface        = "ethernet-1-3"
+        lacpPortPriority = 32768
+        node             = "leaf-1"
+      },
+    ]
+    type = "interface"
+  }
+}
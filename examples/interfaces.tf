@@ -51,17 +51,8 @@ locals {
     }
   }
 
-  interfaces = [{
-    type = {
-      create = {
-        value = local.myif_1
-      }
-    }
-    }, {
-    type = {
-      create = {
-        value = local.myif_2
-      }
-    }
-  }]
+  interfaces = [
+    provider::core-v1::tx_create(local.myif_1),
+    provider::core-v1::tx_create(local.myif_2),
+  ]
 }
@@ -0,0 +1,152 @@
+package datasource_access_check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResultAttrTypes are the attribute types of an element of the results list.
+var ResultAttrTypes = map[string]attr.Type{
+	"access":      types.StringType,
+	"allowed":     types.BoolType,
+	"api_group":   types.StringType,
+	"error":       types.StringType,
+	"namespace":   types.StringType,
+	"permissions": types.StringType,
+	"resource":    types.StringType,
+}
+
+type AccessCheckModel struct {
+	Allowed       types.Bool `tfsdk:"allowed"`
+	ResourceRules types.List `tfsdk:"resource_rules"`
+	Results       types.List `tfsdk:"results"`
+}
+
+// ResourceRuleModel is an element of the resource_rules list, in the same shape
+// as the resource rules of an auth_role, with the namespace to check them in.
+type ResourceRuleModel struct {
+	ApiGroups   types.List   `tfsdk:"api_groups"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Permissions types.String `tfsdk:"permissions"`
+	Resources   types.List   `tfsdk:"resources"`
+}
+
+// ResultModel is an element of the results list, one per api group and resource.
+type ResultModel struct {
+	Access      types.String `tfsdk:"access"`
+	Allowed     types.Bool   `tfsdk:"allowed"`
+	ApiGroup    types.String `tfsdk:"api_group"`
+	Error       types.String `tfsdk:"error"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Permissions types.String `tfsdk:"permissions"`
+	Resource    types.String `tfsdk:"resource"`
+}
+
+func AccessCheckDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allowed": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if the user has the requested permissions on every resource in resource_rules.",
+				MarkdownDescription: "True if the user has the requested permissions on every resource in `resource_rules`.",
+			},
+			"resource_rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_groups": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							Description:         "The API groups for the resources to check.\nAn API group consists of an apiGroup and a version, e.g. \"apigroup/version\".\nWildcards are not supported.",
+							MarkdownDescription: "The API groups for the resources to check.\nAn API group consists of an apiGroup and a version, e.g. \"apigroup/version\".\nWildcards are not supported.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(
+									stringvalidator.NoneOf("*"),
+								),
+							},
+						},
+						"namespace": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The namespace to check the resources in. Omit to check for cluster-wide access",
+							MarkdownDescription: "The namespace to check the resources in. Omit to check for cluster-wide access",
+						},
+						"permissions": schema.StringAttribute{
+							Required:            true,
+							Description:         "Permissions the user needs on the resources.",
+							MarkdownDescription: "Permissions the user needs on the resources.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									"read",
+									"readWrite",
+								),
+							},
+						},
+						"resources": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							Description:         "Names for the resources to check.\nWildcards are not supported.",
+							MarkdownDescription: "Names for the resources to check.\nWildcards are not supported.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(
+									stringvalidator.NoneOf("*"),
+								),
+							},
+						},
+					},
+				},
+				Required:            true,
+				Description:         "Rules for access to resources.",
+				MarkdownDescription: "Rules for access to resources.",
+			},
+			"results": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"access": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Indicates the access level the user has for the requested resource",
+							MarkdownDescription: "Indicates the access level the user has for the requested resource",
+						},
+						"allowed": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "True if the access level covers the requested permissions",
+							MarkdownDescription: "True if the access level covers the requested permissions",
+						},
+						"api_group": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The API group of the resource, as \"apigroup/version\"",
+							MarkdownDescription: "The API group of the resource, as \"apigroup/version\"",
+						},
+						"error": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The error returned for this check, if any",
+							MarkdownDescription: "The error returned for this check, if any",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The namespace that was checked, empty for cluster-wide access",
+							MarkdownDescription: "The namespace that was checked, empty for cluster-wide access",
+						},
+						"permissions": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The requested permissions",
+							MarkdownDescription: "The requested permissions",
+						},
+						"resource": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the resource",
+							MarkdownDescription: "The name of the resource",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The outcome of the check for every api group and resource in resource_rules.",
+				MarkdownDescription: "The outcome of the check for every api group and resource in `resource_rules`.",
+			},
+		},
+	}
+}
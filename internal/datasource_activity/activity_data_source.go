@@ -0,0 +1,30 @@
+package datasource_activity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ActivityModel struct {
+	Active types.Bool   `tfsdk:"active"`
+	Mode   types.String `tfsdk:"mode"`
+}
+
+func ActivityDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if this cluster is the active cluster.",
+				MarkdownDescription: "True if this cluster is the active cluster.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Indication of the activity of this cluster, either ACTIVE or STANDBY.",
+				MarkdownDescription: "Indication of the activity of this cluster, either `ACTIVE` or `STANDBY`.",
+			},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package datasource_alarms
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// CUSTOM MODEL
+// AlarmsCustomDataSourceSchema extends the generated schema with the client-side list options.
+func AlarmsCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	return tfutils.AddListOptions(AlarmsDataSourceSchema(ctx))
+}
+
+type AlarmsCustomModel struct {
+	AlarmsModel
+	tfutils.ListOptionsModel
+}
@@ -0,0 +1,87 @@
+package datasource_auth_provider_check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AuthProviderTestModel struct {
+	Action  types.String `tfsdk:"action"`
+	Auth    types.Object `tfsdk:"auth"`
+	Message types.String `tfsdk:"message"`
+	Name    types.String `tfsdk:"name"`
+	Success types.Bool   `tfsdk:"success"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+	Tls     types.Bool   `tfsdk:"tls"`
+	Url     types.String `tfsdk:"url"`
+}
+
+func AuthProviderTestDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The test action to take.",
+				MarkdownDescription: "The test action to take.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"connection",
+						"authentication",
+					),
+				},
+			},
+			"auth": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"bind_credential": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						Description:         "Credentials to use when binding to an LDAP provider",
+						MarkdownDescription: "Credentials to use when binding to an LDAP provider",
+					},
+					"bind_dn": schema.StringAttribute{
+						Required:            true,
+						Description:         "DN to use when binding to an LDAP provider",
+						MarkdownDescription: "DN to use when binding to an LDAP provider",
+					},
+				},
+				Optional:            true,
+				Description:         "Bind credentials for an authentication test. Not needed if name refers to an existing provider.",
+				MarkdownDescription: "Bind credentials for an authentication test. Not needed if name refers to an existing provider.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The error returned by the test, empty if the test succeeded.",
+				MarkdownDescription: "The error returned by the test, empty if the test succeeded.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of an existing provider whose saved bind credential is used for an authentication test.",
+				MarkdownDescription: "The name of an existing provider whose saved bind credential is used for an authentication test.",
+			},
+			"success": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "True if the connection or authentication test succeeded.",
+				MarkdownDescription: "True if the connection or authentication test succeeded.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "LDAP connection timeout in milliseconds",
+				MarkdownDescription: "LDAP connection timeout in milliseconds",
+			},
+			"tls": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "If true, encrypts the connection to LDAP using STARTTLS",
+				MarkdownDescription: "If true, encrypts the connection to LDAP using STARTTLS",
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				Description:         "Connection URL to your LDAP server",
+				MarkdownDescription: "Connection URL to your LDAP server",
+			},
+		},
+	}
+}
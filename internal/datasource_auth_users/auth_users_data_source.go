@@ -0,0 +1,19 @@
+package datasource_auth_users
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// CUSTOM MODEL
+// AuthUsersCustomDataSourceSchema extends the generated schema with the client-side list options.
+func AuthUsersCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	return tfutils.AddListOptions(AuthUsersDataSourceSchema(ctx))
+}
+
+type AuthUsersCustomModel struct {
+	AuthUsersModel
+	tfutils.ListOptionsModel
+}
@@ -0,0 +1,119 @@
+package datasource_branch_diff_summary
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BranchDiffSummaryModel struct {
+	BranchHeadHash  types.String `tfsdk:"branch_head_hash"`
+	ChangedCrs      types.List   `tfsdk:"changed_crs"`
+	ChangedCrsCount types.Int64  `tfsdk:"changed_crs_count"`
+	Cursor          types.Int64  `tfsdk:"cursor"`
+	Gvk             types.String `tfsdk:"gvk"`
+	Hash            types.String `tfsdk:"hash"`
+	Limit           types.Int64  `tfsdk:"limit"`
+	Search          types.String `tfsdk:"search"`
+	VisibleCrsCount types.Int64  `tfsdk:"visible_crs_count"`
+}
+
+func BranchDiffSummaryDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"branch_head_hash": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The hash of the head of the branch the summary was computed for",
+				MarkdownDescription: "The hash of the head of the branch the summary was computed for",
+			},
+			"changed_crs": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gvk": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"group": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Name of the API group",
+									MarkdownDescription: "Name of the API group",
+								},
+								"kind": schema.StringAttribute{
+									Computed:            true,
+									Description:         "The Kind of the resource",
+									MarkdownDescription: "The Kind of the resource",
+								},
+								"version": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Version of the API group",
+									MarkdownDescription: "Version of the API group",
+								},
+							},
+							Computed: true,
+						},
+						"has_more": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "When limit/search is used: true if more names exist for this category; use nextCursor with gvk to fetch the next page.",
+							MarkdownDescription: "When limit/search is used: true if more names exist for this category; use nextCursor with gvk to fetch the next page.",
+						},
+						"names": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"namespace": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+							Computed: true,
+						},
+						"next_cursor": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "When limit/search is used: cursor for the next page for this category (send with gvk).",
+							MarkdownDescription: "When limit/search is used: cursor for the next page for this category (send with gvk).",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The CRs changed on the branch, one entry per GVK",
+				MarkdownDescription: "The CRs changed on the branch, one entry per GVK",
+			},
+			"changed_crs_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The total number of CRs changed on the branch",
+				MarkdownDescription: "The total number of CRs changed on the branch",
+			},
+			"cursor": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "When gvk is set with limit: offset into that category's names; omit for first page. Ignored when gvk is omitted.",
+				MarkdownDescription: "When gvk is set with limit: offset into that category's names; omit for first page. Ignored when gvk is omitted.",
+			},
+			"gvk": schema.StringAttribute{
+				Optional:            true,
+				Description:         "When set, returns only a single category for that GVK. Must be valid JSON for GroupVersionKind. Omit to return all categories.",
+				MarkdownDescription: "When set, returns only a single category for that GVK. Must be valid JSON for GroupVersionKind. Omit to return all categories.",
+			},
+			"hash": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Branch head hash; response is only returned if this matches the current branch state.",
+				MarkdownDescription: "Branch head hash; response is only returned if this matches the current branch state.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Max names per category per page. Omit or 0: return all names (no pagination). Max 1000.",
+				MarkdownDescription: "Max names per category per page. Omit or 0: return all names (no pagination). Max 1000.",
+			},
+			"search": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Filter names by case-insensitive substring match on name, namespace, or \"name (namespace)\" display.",
+				MarkdownDescription: "Filter names by case-insensitive substring match on name, namespace, or \"name (namespace)\" display.",
+			},
+			"visible_crs_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of changed CRs visible to the user",
+				MarkdownDescription: "The number of changed CRs visible to the user",
+			},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package datasource_branch_status
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BranchStatusModel struct {
+	BranchName  types.String `tfsdk:"branch_name"`
+	MainCluster types.Object `tfsdk:"main_cluster"`
+	Mode        types.String `tfsdk:"mode"`
+}
+
+func BranchStatusDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"branch_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the branch, when EDA is running in branch mode",
+				MarkdownDescription: "The name of the branch, when EDA is running in branch mode",
+			},
+			"main_cluster": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"domain_name": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The external domain name of the main/production cluster",
+						MarkdownDescription: "The external domain name of the main/production cluster",
+					},
+					"https_port": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "HTTPS port used to reach the main/production cluster externally",
+						MarkdownDescription: "HTTPS port used to reach the main/production cluster externally",
+					},
+					"ipv4_address": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The external IPv4 address of the main/production cluster",
+						MarkdownDescription: "The external IPv4 address of the main/production cluster",
+					},
+					"ipv6_address": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The external IPv6 address of the main/production cluster",
+						MarkdownDescription: "The external IPv6 address of the main/production cluster",
+					},
+				},
+				Computed:            true,
+				Description:         "How to reach the main/production cluster, when EDA is running in branch mode",
+				MarkdownDescription: "How to reach the main/production cluster, when EDA is running in branch mode",
+			},
+			"mode": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Whether EDA is running as the main cluster or as a branch",
+				MarkdownDescription: "Whether EDA is running as the main cluster or as a branch",
+			},
+		},
+	}
+}
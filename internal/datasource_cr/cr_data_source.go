@@ -0,0 +1,60 @@
+package datasource_cr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CrModel struct {
+	ApiVersion types.String  `tfsdk:"api_version"`
+	Kind       types.String  `tfsdk:"kind"`
+	Metadata   types.Dynamic `tfsdk:"metadata"`
+	Name       types.String  `tfsdk:"name"`
+	Namespace  types.String  `tfsdk:"namespace"`
+	Spec       types.Dynamic `tfsdk:"spec"`
+	Status     types.Dynamic `tfsdk:"status"`
+}
+
+func CrDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_version": schema.StringAttribute{
+				Required:            true,
+				Description:         "The API group and version of the CR, e.g. \"core.eda.nokia.com/v1\".",
+				MarkdownDescription: "The API group and version of the CR, e.g. `\"core.eda.nokia.com/v1\"`.",
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				Description:         "The kind of the CR, e.g. \"TopoNode\".",
+				MarkdownDescription: "The kind of the CR, e.g. `\"TopoNode\"`.",
+			},
+			"metadata": schema.DynamicAttribute{
+				Computed:            true,
+				Description:         "Metadata of the CR",
+				MarkdownDescription: "Metadata of the CR",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the CR",
+				MarkdownDescription: "Name of the CR",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Namespace of the CR, omit for cluster scoped CRs",
+				MarkdownDescription: "Namespace of the CR, omit for cluster scoped CRs",
+			},
+			"spec": schema.DynamicAttribute{
+				Computed:            true,
+				Description:         "Spec of the CR",
+				MarkdownDescription: "Spec of the CR",
+			},
+			"status": schema.DynamicAttribute{
+				Computed:            true,
+				Description:         "Status of the CR",
+				MarkdownDescription: "Status of the CR",
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package datasource_crs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CrsModel struct {
+	ApiVersion    types.String  `tfsdk:"api_version"`
+	FieldSelector types.String  `tfsdk:"field_selector"`
+	Items         types.Dynamic `tfsdk:"items"`
+	Kind          types.String  `tfsdk:"kind"`
+	LabelSelector types.String  `tfsdk:"label_selector"`
+	Names         types.List    `tfsdk:"names"`
+	Namespace     types.String  `tfsdk:"namespace"`
+}
+
+func CrsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_version": schema.StringAttribute{
+				Required:            true,
+				Description:         "The API group and version of the CRs, e.g. \"core.eda.nokia.com/v1\".",
+				MarkdownDescription: "The API group and version of the CRs, e.g. `\"core.eda.nokia.com/v1\"`.",
+			},
+			"field_selector": schema.StringAttribute{
+				Optional:            true,
+				Description:         "An EQL where clause the CRs must match, e.g. spec.platform = \"7220 IXR-D3L\".",
+				MarkdownDescription: "An EQL where clause the CRs must match, e.g. `spec.platform = \"7220 IXR-D3L\"`.",
+			},
+			"items": schema.DynamicAttribute{
+				Computed:            true,
+				Description:         "The matching CRs keyed by namespace/name, or by name for cluster scoped CRs. Each has name, namespace, metadata, spec and status.",
+				MarkdownDescription: "The matching CRs keyed by `namespace/name`, or by `name` for cluster scoped CRs. Each has `name`, `namespace`, `metadata`, `spec` and `status`.",
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				Description:         "The kind of the CRs, e.g. \"TopoNode\".",
+				MarkdownDescription: "The kind of the CRs, e.g. `\"TopoNode\"`.",
+			},
+			"label_selector": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A label selector the CRs must match, in the Kubernetes format, e.g. \"eda.nokia.com/role=leaf,!draining\".",
+				MarkdownDescription: "A label selector the CRs must match, in the Kubernetes format, e.g. `\"eda.nokia.com/role=leaf,!draining\"`.",
+			},
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The keys of items, sorted.",
+				MarkdownDescription: "The keys of `items`, sorted.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The namespace to look up the CRs in, omit to look in all namespaces.",
+				MarkdownDescription: "The namespace to look up the CRs in, omit to look in all namespaces.",
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package datasource_eql_stream_result
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CUSTOM MODEL
+// EqlStreamResultCustomDataSourceSchema extends the generated schema with the rows of the result,
+// typed according to json_schema, and with the columns of the rows.
+func EqlStreamResultCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	s := EqlStreamResultDataSourceSchema(ctx)
+	s.Attributes["columns"] = schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed:            true,
+					Description:         "The name of the field.",
+					MarkdownDescription: "The name of the field.",
+				},
+				"type": schema.StringAttribute{
+					Computed:            true,
+					Description:         "The JSON schema type of the field, such as string, integer, number, boolean, object or array. Empty when the result has no JSON schema for the field.",
+					MarkdownDescription: "The JSON schema type of the field, such as `string`, `integer`, `number`, `boolean`, `object` or `array`. Empty when the result has no JSON schema for the field.",
+				},
+			},
+		},
+		Computed:            true,
+		Description:         "The fields of the rows, sorted by name.",
+		MarkdownDescription: "The fields of the rows, sorted by name.",
+	}
+	s.Attributes["rows"] = schema.DynamicAttribute{
+		Computed:            true,
+		Description:         "The rows of the result, a list of objects with one attribute per column, typed according to json_schema. A field missing from a row is null.",
+		MarkdownDescription: "The rows of the result, a list of objects with one attribute per column, typed according to `json_schema`. A field missing from a row is `null`.",
+	}
+	return s
+}
+
+type EqlStreamResultCustomModel struct {
+	Columns    types.List      `tfsdk:"columns"`
+	Data       types.List      `tfsdk:"data"`
+	JsonSchema JsonSchemaValue `tfsdk:"json_schema"`
+	Namespaces types.String    `tfsdk:"namespaces"`
+	Query      types.String    `tfsdk:"query"`
+	Rows       types.Dynamic   `tfsdk:"rows"`
+}
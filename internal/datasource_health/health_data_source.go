@@ -0,0 +1,44 @@
+package datasource_health
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// CUSTOM MODEL
+// HealthCustomDataSourceSchema extends the generated schema with a readiness summary,
+// with attributes that make the read wait for the EDA cluster to become healthy, and
+// with the fields of the health report that the schema does not have yet.
+func HealthCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	s := HealthDataSourceSchema(ctx)
+	s.Attributes["healthy"] = schema.BoolAttribute{
+		Computed:            true,
+		Description:         "True if the overall status and the status of every service is UP.",
+		MarkdownDescription: "True if the overall status and the status of every service is `UP`.",
+	}
+	s.Attributes["wait_until_healthy"] = schema.BoolAttribute{
+		Optional:            true,
+		Description:         "If true, the health report is polled until the EDA cluster is healthy, or until wait_timeout expires.",
+		MarkdownDescription: "If true, the health report is polled until the EDA cluster is healthy, or until `wait_timeout` expires.",
+	}
+	s.Attributes["wait_timeout"] = schema.StringAttribute{
+		Optional:            true,
+		Description:         "How long to wait for the EDA cluster to become healthy, as a duration string such as \"10m\". Defaults to 10m.",
+		MarkdownDescription: "How long to wait for the EDA cluster to become healthy, as a duration string such as `\"10m\"`. Defaults to `10m`.",
+	}
+	return tfutils.AddExtraAttribute(s)
+}
+
+type HealthCustomModel struct {
+	Extra            types.Dynamic `tfsdk:"extra"`
+	Healthy          types.Bool    `tfsdk:"healthy"`
+	Mode             types.String  `tfsdk:"mode"`
+	Services         types.Map     `tfsdk:"services"`
+	Status           types.String  `tfsdk:"status"`
+	Timestamp        types.String  `tfsdk:"timestamp"`
+	WaitTimeout      types.String  `tfsdk:"wait_timeout"`
+	WaitUntilHealthy types.Bool    `tfsdk:"wait_until_healthy"`
+}
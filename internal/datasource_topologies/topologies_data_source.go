@@ -0,0 +1,19 @@
+package datasource_topologies
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// CUSTOM MODEL
+// TopologiesCustomDataSourceSchema extends the generated schema with the client-side list options.
+func TopologiesCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	return tfutils.AddListOptions(TopologiesDataSourceSchema(ctx))
+}
+
+type TopologiesCustomModel struct {
+	TopologiesModel
+	tfutils.ListOptionsModel
+}
@@ -0,0 +1,449 @@
+package datasource_topology_state
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OverlayStateAttrTypes are the attribute types of the state of an element in an overlay.
+var OverlayStateAttrTypes = map[string]attr.Type{
+	"badges": types.ListType{ElemType: types.Int64Type},
+	"state":  types.Int64Type,
+}
+
+// EndpointAttrTypes are the attribute types of an endpoint of a link.
+var EndpointAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"node":     types.StringType,
+	"node_key": types.StringType,
+	"overlays": types.MapType{ElemType: types.ObjectType{AttrTypes: OverlayStateAttrTypes}},
+}
+
+// GroupingAttrTypes are the attribute types of the group and tier of a node.
+var GroupingAttrTypes = map[string]attr.Type{
+	"group":         types.StringType,
+	"group_key":     types.StringType,
+	"group_ui_name": types.StringType,
+	"tier":          types.Int64Type,
+}
+
+// NodeAttrTypes are the attribute types of an element of the nodes map.
+var NodeAttrTypes = map[string]attr.Type{
+	"cr_name":   types.StringType,
+	"grouping":  types.ObjectType{AttrTypes: GroupingAttrTypes},
+	"key":       types.StringType,
+	"labels":    types.MapType{ElemType: types.StringType},
+	"name":      types.StringType,
+	"namespace": types.StringType,
+	"overlays":  types.MapType{ElemType: types.ObjectType{AttrTypes: OverlayStateAttrTypes}},
+}
+
+// NodeOrGroupAttrTypes are the attribute types of an element of the nodes_and_groups map.
+var NodeOrGroupAttrTypes = map[string]attr.Type{
+	"cr_name":   types.StringType,
+	"grouping":  types.ObjectType{AttrTypes: GroupingAttrTypes},
+	"key":       types.StringType,
+	"labels":    types.MapType{ElemType: types.StringType},
+	"name":      types.StringType,
+	"namespace": types.StringType,
+	"num_nodes": types.Int64Type,
+	"overlays":  types.MapType{ElemType: types.ObjectType{AttrTypes: OverlayStateAttrTypes}},
+	"type":      types.StringType,
+}
+
+// LinkAttrTypes are the attribute types of an element of the links map.
+var LinkAttrTypes = map[string]attr.Type{
+	"cr_name":    types.StringType,
+	"endpoint_a": types.ObjectType{AttrTypes: EndpointAttrTypes},
+	"endpoint_b": types.ObjectType{AttrTypes: EndpointAttrTypes},
+	"group_key":  types.StringType,
+	"key":        types.StringType,
+	"labels":     types.MapType{ElemType: types.StringType},
+	"name":       types.StringType,
+	"namespace":  types.StringType,
+	"overlays":   types.MapType{ElemType: types.ObjectType{AttrTypes: OverlayStateAttrTypes}},
+}
+
+// LinkGroupEndpointAttrTypes are the attribute types of an end of a link group.
+var LinkGroupEndpointAttrTypes = map[string]attr.Type{
+	"group_key": types.StringType,
+	"node_key":  types.StringType,
+}
+
+// LinkGroupAttrTypes are the attribute types of an element of the link_groups map.
+var LinkGroupAttrTypes = map[string]attr.Type{
+	"endpoint_a": types.ObjectType{AttrTypes: LinkGroupEndpointAttrTypes},
+	"endpoint_b": types.ObjectType{AttrTypes: LinkGroupEndpointAttrTypes},
+	"key":        types.StringType,
+	"overlays":   types.MapType{ElemType: types.ObjectType{AttrTypes: OverlayStateAttrTypes}},
+}
+
+type TopologyStateModel struct {
+	LinkGroups     types.Map    `tfsdk:"link_groups"`
+	LinkSelector   types.List   `tfsdk:"link_selector"`
+	Links          types.Map    `tfsdk:"links"`
+	Namespace      types.String `tfsdk:"namespace"`
+	NodeSelector   types.List   `tfsdk:"node_selector"`
+	Nodes          types.Map    `tfsdk:"nodes"`
+	NodesAndGroups types.Map    `tfsdk:"nodes_and_groups"`
+	Overlays       types.List   `tfsdk:"overlays"`
+	TopologyName   types.String `tfsdk:"topology_name"`
+}
+
+// OverlayStateModel is the state of a node, link or endpoint in an overlay.
+type OverlayStateModel struct {
+	Badges []int64     `tfsdk:"badges"`
+	State  types.Int64 `tfsdk:"state"`
+}
+
+// EndpointModel is an endpoint of a link.
+type EndpointModel struct {
+	Name     types.String                 `tfsdk:"name"`
+	Node     types.String                 `tfsdk:"node"`
+	NodeKey  types.String                 `tfsdk:"node_key"`
+	Overlays map[string]OverlayStateModel `tfsdk:"overlays"`
+}
+
+// GroupingModel is the group and tier of a node.
+type GroupingModel struct {
+	Group       types.String `tfsdk:"group"`
+	GroupKey    types.String `tfsdk:"group_key"`
+	GroupUiName types.String `tfsdk:"group_ui_name"`
+	Tier        types.Int64  `tfsdk:"tier"`
+}
+
+// NodeModel is an element of the nodes map.
+type NodeModel struct {
+	CrName    types.String                 `tfsdk:"cr_name"`
+	Grouping  GroupingModel                `tfsdk:"grouping"`
+	Key       types.String                 `tfsdk:"key"`
+	Labels    map[string]string            `tfsdk:"labels"`
+	Name      types.String                 `tfsdk:"name"`
+	Namespace types.String                 `tfsdk:"namespace"`
+	Overlays  map[string]OverlayStateModel `tfsdk:"overlays"`
+}
+
+// NodeOrGroupModel is an element of the nodes_and_groups map.
+type NodeOrGroupModel struct {
+	CrName    types.String                 `tfsdk:"cr_name"`
+	Grouping  GroupingModel                `tfsdk:"grouping"`
+	Key       types.String                 `tfsdk:"key"`
+	Labels    map[string]string            `tfsdk:"labels"`
+	Name      types.String                 `tfsdk:"name"`
+	Namespace types.String                 `tfsdk:"namespace"`
+	NumNodes  types.Int64                  `tfsdk:"num_nodes"`
+	Overlays  map[string]OverlayStateModel `tfsdk:"overlays"`
+	Type      types.String                 `tfsdk:"type"`
+}
+
+// LinkModel is an element of the links map.
+type LinkModel struct {
+	CrName    types.String                 `tfsdk:"cr_name"`
+	EndpointA EndpointModel                `tfsdk:"endpoint_a"`
+	EndpointB EndpointModel                `tfsdk:"endpoint_b"`
+	GroupKey  types.String                 `tfsdk:"group_key"`
+	Key       types.String                 `tfsdk:"key"`
+	Labels    map[string]string            `tfsdk:"labels"`
+	Name      types.String                 `tfsdk:"name"`
+	Namespace types.String                 `tfsdk:"namespace"`
+	Overlays  map[string]OverlayStateModel `tfsdk:"overlays"`
+}
+
+// LinkGroupEndpointModel is an end of a link group.
+type LinkGroupEndpointModel struct {
+	GroupKey types.String `tfsdk:"group_key"`
+	NodeKey  types.String `tfsdk:"node_key"`
+}
+
+// LinkGroupModel is an element of the link_groups map.
+type LinkGroupModel struct {
+	EndpointA LinkGroupEndpointModel       `tfsdk:"endpoint_a"`
+	EndpointB LinkGroupEndpointModel       `tfsdk:"endpoint_b"`
+	Key       types.String                 `tfsdk:"key"`
+	Overlays  map[string]OverlayStateModel `tfsdk:"overlays"`
+}
+
+func TopologyStateDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"link_groups": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint_a": linkGroupEndpointAttribute("The first end of the link group."),
+						"endpoint_b": linkGroupEndpointAttribute("The second end of the link group."),
+						"key": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The key of the link group.",
+							MarkdownDescription: "The key of the link group.",
+						},
+						"overlays": overlaysAttribute("link group"),
+					},
+				},
+				Computed:            true,
+				Description:         "The groups of links between node groups, keyed by link group key.",
+				MarkdownDescription: "The groups of links between node groups, keyed by link group key.",
+			},
+			"link_selector": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Label selectors the links must match to be returned, e.g. [\"eda.nokia.com/role=interSwitch\"]. Defaults to all links.",
+				MarkdownDescription: "Label selectors the links must match to be returned, e.g. `[\"eda.nokia.com/role=interSwitch\"]`. Defaults to all links.",
+			},
+			"links": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cr_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the CR backing the link.",
+							MarkdownDescription: "The name of the CR backing the link.",
+						},
+						"endpoint_a": endpointAttribute("The first endpoint of the link."),
+						"endpoint_b": endpointAttribute("The second endpoint of the link."),
+						"group_key": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The key of the link group the link belongs to.",
+							MarkdownDescription: "The key of the link group the link belongs to.",
+						},
+						"key": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The key of the link.",
+							MarkdownDescription: "The key of the link.",
+						},
+						"labels": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The labels of the link.",
+							MarkdownDescription: "The labels of the link.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the link.",
+							MarkdownDescription: "The name of the link.",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The namespace of the link.",
+							MarkdownDescription: "The namespace of the link.",
+						},
+						"overlays": overlaysAttribute("link"),
+					},
+				},
+				Computed:            true,
+				Description:         "The links of the topology, keyed by link key. Endpoints are only returned as the ends of links, the API has no separate list of endpoints.",
+				MarkdownDescription: "The links of the topology, keyed by link key. Endpoints are only returned as the ends of links, the API has no separate list of endpoints.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The namespace to get the state from. Defaults to all namespaces.",
+				MarkdownDescription: "The namespace to get the state from. Defaults to all namespaces.",
+			},
+			"node_selector": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Label selectors the nodes must match to be returned, e.g. [\"eda.nokia.com/role=leaf\"]. Defaults to all nodes.",
+				MarkdownDescription: "Label selectors the nodes must match to be returned, e.g. `[\"eda.nokia.com/role=leaf\"]`. Defaults to all nodes.",
+			},
+			"nodes": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cr_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the CR backing the node.",
+							MarkdownDescription: "The name of the CR backing the node.",
+						},
+						"grouping": groupingAttribute("node"),
+						"key": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The key of the node.",
+							MarkdownDescription: "The key of the node.",
+						},
+						"labels": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The labels of the node.",
+							MarkdownDescription: "The labels of the node.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the node.",
+							MarkdownDescription: "The name of the node.",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The namespace of the node.",
+							MarkdownDescription: "The namespace of the node.",
+						},
+						"overlays": overlaysAttribute("node"),
+					},
+				},
+				Computed:            true,
+				Description:         "The nodes of the topology, keyed by node key. The UI fields of the nodes and links, such as their display name and attributes, are not returned.",
+				MarkdownDescription: "The nodes of the topology, keyed by node key. The UI fields of the nodes and links, such as their display name and attributes, are not returned.",
+			},
+			"nodes_and_groups": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cr_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the CR backing the node, empty for a group.",
+							MarkdownDescription: "The name of the CR backing the node, empty for a group.",
+						},
+						"grouping": groupingAttribute("node or group"),
+						"key": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The key of the node or group.",
+							MarkdownDescription: "The key of the node or group.",
+						},
+						"labels": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The labels of the node or group.",
+							MarkdownDescription: "The labels of the node or group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the node or group.",
+							MarkdownDescription: "The name of the node or group.",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The namespace of the node or group.",
+							MarkdownDescription: "The namespace of the node or group.",
+						},
+						"num_nodes": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of nodes in the group.",
+							MarkdownDescription: "The number of nodes in the group.",
+						},
+						"overlays": overlaysAttribute("node or group"),
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Whether the element is a node or a group.",
+							MarkdownDescription: "Whether the element is a node or a group.",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The nodes of the topology, with the nodes of collapsed groups replaced by their group, keyed by node or group key.",
+				MarkdownDescription: "The nodes of the topology, with the nodes of collapsed groups replaced by their group, keyed by node or group key.",
+			},
+			"overlays": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The overlays to get the state and badges of, such as the oper-state overlay.",
+				MarkdownDescription: "The overlays to get the state and badges of, such as the `oper-state` overlay.",
+			},
+			"topology_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the topology to get the state of.",
+				MarkdownDescription: "The name of the topology to get the state of.",
+			},
+		},
+	}
+}
+
+// overlaysAttribute returns the schema of the per overlay state of a node, link or endpoint.
+func overlaysAttribute(element string) schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"badges": schema.ListAttribute{
+					ElementType:         types.Int64Type,
+					Computed:            true,
+					Description:         "The badge values of the " + element + " in the overlay, only set for nodes.",
+					MarkdownDescription: "The badge values of the " + element + " in the overlay, only set for nodes.",
+				},
+				"state": schema.Int64Attribute{
+					Computed:            true,
+					Description:         "The state value of the " + element + " in the overlay.",
+					MarkdownDescription: "The state value of the " + element + " in the overlay.",
+				},
+			},
+		},
+		Computed:            true,
+		Description:         "The state of the " + element + " in each requested overlay, keyed by overlay name.",
+		MarkdownDescription: "The state of the " + element + " in each requested overlay, keyed by overlay name.",
+	}
+}
+
+// endpointAttribute returns the schema of an endpoint of a link.
+func endpointAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the endpoint.",
+				MarkdownDescription: "The name of the endpoint.",
+			},
+			"node": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The node of the endpoint.",
+				MarkdownDescription: "The node of the endpoint.",
+			},
+			"node_key": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The key of the node of the endpoint.",
+				MarkdownDescription: "The key of the node of the endpoint.",
+			},
+			"overlays": overlaysAttribute("endpoint"),
+		},
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+// groupingAttribute returns the schema of the group and tier of a node or group.
+func groupingAttribute(element string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The group of the " + element + ".",
+				MarkdownDescription: "The group of the " + element + ".",
+			},
+			"group_key": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The key of the group of the " + element + ".",
+				MarkdownDescription: "The key of the group of the " + element + ".",
+			},
+			"group_ui_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the group of the " + element + " shown in the UI.",
+				MarkdownDescription: "The name of the group of the " + element + " shown in the UI.",
+			},
+			"tier": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The tier of the " + element + ".",
+				MarkdownDescription: "The tier of the " + element + ".",
+			},
+		},
+		Computed:            true,
+		Description:         "The group and tier of the " + element + ".",
+		MarkdownDescription: "The group and tier of the " + element + ".",
+	}
+}
+
+// linkGroupEndpointAttribute returns the schema of an end of a link group.
+func linkGroupEndpointAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"group_key": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The key of the node group at this end, if the nodes are grouped.",
+				MarkdownDescription: "The key of the node group at this end, if the nodes are grouped.",
+			},
+			"node_key": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The key of the node at this end, if it is not grouped.",
+				MarkdownDescription: "The key of the node at this end, if it is not grouped.",
+			},
+		},
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
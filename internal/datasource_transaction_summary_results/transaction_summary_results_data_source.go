@@ -0,0 +1,19 @@
+package datasource_transaction_summary_results
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// CUSTOM MODEL
+// TransactionSummaryResultsCustomDataSourceSchema extends the generated schema with the client-side list options.
+func TransactionSummaryResultsCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	return tfutils.AddListOptions(TransactionSummaryResultsDataSourceSchema(ctx))
+}
+
+type TransactionSummaryResultsCustomModel struct {
+	TransactionSummaryResultsModel
+	tfutils.ListOptionsModel
+}
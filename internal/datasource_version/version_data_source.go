@@ -0,0 +1,63 @@
+package datasource_version
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ComponentAttrTypes are the attribute types of an element of the components map.
+var ComponentAttrTypes = map[string]attr.Type{
+	"built_date": types.StringType,
+	"version":    types.StringType,
+}
+
+type VersionModel struct {
+	BuiltDate  types.String `tfsdk:"built_date"`
+	Components types.Map    `tfsdk:"components"`
+	Version    types.String `tfsdk:"version"`
+}
+
+// ComponentModel is an element of the components map.
+type ComponentModel struct {
+	BuiltDate types.String `tfsdk:"built_date"`
+	Version   types.String `tfsdk:"version"`
+}
+
+func VersionDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"built_date": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The build-time of the overall EDA product.",
+				MarkdownDescription: "The build-time of the overall EDA product.",
+			},
+			"components": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"built_date": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The build-time for the component.",
+							MarkdownDescription: "The build-time for the component.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The version string for the component.",
+							MarkdownDescription: "The version string for the component.",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "Version information for subsystems and the overall EDA product. Keyed by the name of the component.",
+				MarkdownDescription: "Version information for subsystems and the overall EDA product. Keyed by the name of the component.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The version string of the overall EDA product.",
+				MarkdownDescription: "The version string of the overall EDA product.",
+			},
+		},
+	}
+}
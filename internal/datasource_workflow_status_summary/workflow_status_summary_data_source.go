@@ -0,0 +1,19 @@
+package datasource_workflow_status_summary
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// CUSTOM MODEL
+// WorkflowStatusSummaryCustomDataSourceSchema extends the generated schema with the client-side list options.
+func WorkflowStatusSummaryCustomDataSourceSchema(ctx context.Context) schema.Schema {
+	return tfutils.AddListOptions(WorkflowStatusSummaryDataSourceSchema(ctx))
+}
+
+type WorkflowStatusSummaryCustomModel struct {
+	WorkflowStatusSummaryModel
+	tfutils.ListOptionsModel
+}
@@ -5,6 +5,8 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +32,23 @@ const (
 	CLIENT_URL   = KEYCLOAK_URL + "/admin/realms/{realm}/clients"
 )
 
+// ApiError is returned when the EDA API responds with an error status.
+type ApiError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("%s %s", e.Status, e.Body)
+}
+
+// IsNotFound reports whether err is an ApiError for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 type grant struct {
 	AccessToken   string  `json:"access_token"`
 	RefreshToken  string  `json:"refresh_token"`
@@ -255,11 +274,15 @@ func (c *EdaApiClient) Create(ctx context.Context, pathUrl string, pathParams ma
 	return c.Execute(ctx, pathUrl, rest.HTTP_POST, pathParams, nil, body, result)
 }
 
+func (c *EdaApiClient) CreateByQuery(ctx context.Context, pathUrl string, pathParams map[string]string, queryParams url.Values, body any, result any) error {
+	return c.Execute(ctx, pathUrl, rest.HTTP_POST, pathParams, queryParams, body, result)
+}
+
 func (c *EdaApiClient) Get(ctx context.Context, pathUrl string, pathParams map[string]string, result any) error {
 	return c.Execute(ctx, pathUrl, rest.HTTP_GET, pathParams, nil, nil, result)
 }
 
-func (c *EdaApiClient) GetByQuery(ctx context.Context, pathUrl string, pathParams, queryParams map[string]string, result any) error {
+func (c *EdaApiClient) GetByQuery(ctx context.Context, pathUrl string, pathParams map[string]string, queryParams url.Values, result any) error {
 	return c.Execute(ctx, pathUrl, rest.HTTP_GET, pathParams, queryParams, nil, result)
 }
 
@@ -267,12 +290,20 @@ func (c *EdaApiClient) Update(ctx context.Context, pathUrl string, pathParams ma
 	return c.Execute(ctx, pathUrl, rest.HTTP_PUT, pathParams, nil, body, result)
 }
 
+func (c *EdaApiClient) UpdateByQuery(ctx context.Context, pathUrl string, pathParams map[string]string, queryParams url.Values, body any, result any) error {
+	return c.Execute(ctx, pathUrl, rest.HTTP_PUT, pathParams, queryParams, body, result)
+}
+
 func (c *EdaApiClient) Delete(ctx context.Context, pathUrl string, pathParams map[string]string, result any) error {
 	return c.Execute(ctx, pathUrl, rest.HTTP_DELETE, pathParams, nil, nil, result)
 }
 
+func (c *EdaApiClient) DeleteByQuery(ctx context.Context, pathUrl string, pathParams map[string]string, queryParams url.Values, result any) error {
+	return c.Execute(ctx, pathUrl, rest.HTTP_DELETE, pathParams, queryParams, nil, result)
+}
+
 func (c *EdaApiClient) Execute(ctx context.Context, pathUrl, method string,
-	pathParams, queryParams map[string]string, body, result any) error {
+	pathParams map[string]string, queryParams url.Values, body, result any) error {
 	accessToken, err := c.getEdaAccessToken()
 	if err != nil {
 		return err
@@ -290,7 +321,7 @@ func (c *EdaApiClient) Execute(ctx context.Context, pathUrl, method string,
 		"timeTaken": resp.Time().String(),
 	})
 	if resp.IsError() {
-		return fmt.Errorf("%s %s", resp.Status(), resp.String())
+		return &ApiError{StatusCode: resp.StatusCode(), Status: resp.Status(), Body: resp.String()}
 	}
 	return nil
 }
@@ -1,8 +1,11 @@
 package rest
 
 import (
+	"bytes"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -24,9 +27,17 @@ type ApiClient struct {
 
 func CreateApiClient() *ApiClient {
 	client := resty.New()
+	// Numbers are decoded as json.Number, so that 64-bit integers keep their precision
+	client.SetJSONUnmarshaler(unmarshalJSON)
 	return &ApiClient{restClient: client}
 }
 
+func unmarshalJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
 func (c *ApiClient) WithBaseURL(baseUrl string) *ApiClient {
 	c.restClient.SetBaseURL(baseUrl)
 	return c
@@ -122,13 +133,13 @@ func (c *ApiClient) DoExecute(
 	body any,
 	result any,
 	pathParams map[string]string,
-	queryParams map[string]string,
+	queryParams url.Values,
 	headers map[string]string) (*resty.Response, error) {
 
 	request := c.restClient.R().
 		SetAuthToken(accessToken).
 		SetPathParams(pathParams).
-		SetQueryParams(queryParams).
+		SetQueryParamsFromValues(queryParams).
 		SetBody(body).
 		SetResult(result).
 		SetHeaders(headers)
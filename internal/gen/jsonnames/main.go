@@ -0,0 +1,257 @@
+// Command jsonnames generates the table of the API names of the attributes of the
+// request and response bodies of the API operations from the OpenAPI specification,
+// for the JSONNames of tfutils.NamingRules.
+//
+// Usage:
+//
+//	go run ./internal/gen/jsonnames -oas specs/oas.json -out internal/provider/json_names_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+type oasSchema struct {
+	Ref                  string                `json:"$ref"`
+	Type                 string                `json:"type"`
+	Properties           map[string]*oasSchema `json:"properties"`
+	Items                *oasSchema            `json:"items"`
+	AdditionalProperties json.RawMessage       `json:"additionalProperties"`
+	AllOf                []*oasSchema          `json:"allOf"`
+	AnyOf                []*oasSchema          `json:"anyOf"`
+	OneOf                []*oasSchema          `json:"oneOf"`
+}
+
+type oasMediaType struct {
+	Schema *oasSchema `json:"schema"`
+}
+
+type oasBody struct {
+	Ref     string                  `json:"$ref"`
+	Content map[string]oasMediaType `json:"content"`
+}
+
+type oasParameter struct {
+	Ref  string `json:"$ref"`
+	Name string `json:"name"`
+}
+
+type oasOperation struct {
+	Parameters  []oasParameter     `json:"parameters"`
+	RequestBody *oasBody           `json:"requestBody"`
+	Responses   map[string]oasBody `json:"responses"`
+}
+
+type oasSpec struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas       map[string]*oasSchema   `json:"schemas"`
+		Parameters    map[string]oasParameter `json:"parameters"`
+		RequestBodies map[string]oasBody      `json:"requestBodies"`
+		Responses     map[string]oasBody      `json:"responses"`
+	} `json:"components"`
+}
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+func main() {
+	oasFile := flag.String("oas", "specs/oas.json", "the OpenAPI specification")
+	outFile := flag.String("out", "json_names_gen.go", "the generated Go file")
+	pkg := flag.String("package", "provider", "the package of the generated Go file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*oasFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec := oasSpec{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		log.Fatalf("failed to parse %s: %v", *oasFile, err)
+	}
+
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by jsonnames from %s. DO NOT EDIT.\n\n", strings.TrimLeft(*oasFile, "./"))
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+	fmt.Fprintf(&buf, "// oasJSONNameTable holds the API names of the attributes of the request and response\n")
+	fmt.Fprintf(&buf, "// bodies of the API operations, by method and path, see tfutils.NamingRules.\n")
+	fmt.Fprintf(&buf, "var oasJSONNameTable = map[string]map[string]string{\n")
+	for _, path := range paths {
+		item := spec.Paths[path]
+		common := []oasParameter{}
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &common); err != nil {
+				log.Fatalf("failed to parse the parameters of %s: %v", path, err)
+			}
+		}
+		for _, method := range methods {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+			op := oasOperation{}
+			if err := json.Unmarshal(raw, &op); err != nil {
+				log.Fatalf("failed to parse %s %s: %v", method, path, err)
+			}
+			op.Parameters = append(append([]oasParameter{}, common...), op.Parameters...)
+			names := operationNames(spec, op)
+			if len(names) == 0 {
+				continue
+			}
+			keys := make([]string, 0, len(names))
+			for key := range names {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			fmt.Fprintf(&buf, "\t%q: {\n", strings.ToUpper(method)+" "+path)
+			for _, key := range keys {
+				fmt.Fprintf(&buf, "\t\t%q: %q,\n", key, names[key])
+			}
+			fmt.Fprintf(&buf, "\t},\n")
+		}
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format the generated code: %v", err)
+	}
+	if err := os.WriteFile(*outFile, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// operationNames returns the API names of the properties of the JSON request and
+// successful response bodies of an operation, by key. The keys found with different
+// names are left out, for the converter to fall back to its naming heuristics, and so
+// are the properties named like a parameter of the operation, as the attribute of the
+// model is the parameter, e.g. the fullRoles flag rather than the fullRoles list.
+func operationNames(spec oasSpec, op oasOperation) map[string]string {
+	params := map[string]bool{}
+	for _, p := range op.Parameters {
+		if p.Ref != "" {
+			ref, ok := spec.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+			if !ok {
+				log.Fatalf("unresolved parameter reference %s", p.Ref)
+			}
+			p = ref
+		}
+		params[tfutils.NameKey(p.Name)] = true
+	}
+
+	bodies := []oasBody{}
+	if op.RequestBody != nil {
+		bodies = append(bodies, *op.RequestBody)
+	}
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		bodies = append(bodies, op.Responses[code])
+	}
+
+	names := map[string]string{}
+	ambiguous := map[string]bool{}
+	for _, body := range bodies {
+		body = resolveBody(spec, body)
+		media, ok := body.Content["application/json"]
+		if !ok || media.Schema == nil {
+			continue
+		}
+		collectNames(spec, media.Schema, "", map[string]bool{}, func(key, name string) {
+			if params[strings.SplitN(key, ".", 2)[0]] {
+				return
+			}
+			if prev, ok := names[key]; ok && prev != name {
+				ambiguous[key] = true
+			}
+			names[key] = name
+		})
+	}
+	for key := range ambiguous {
+		delete(names, key)
+	}
+	return names
+}
+
+// resolveBody returns the request body or response a body refers to, if any.
+func resolveBody(spec oasSpec, body oasBody) oasBody {
+	if body.Ref == "" {
+		return body
+	}
+	name := body.Ref[strings.LastIndex(body.Ref, "/")+1:]
+	var ref oasBody
+	var ok bool
+	switch {
+	case strings.HasPrefix(body.Ref, "#/components/requestBodies/"):
+		ref, ok = spec.Components.RequestBodies[name]
+	case strings.HasPrefix(body.Ref, "#/components/responses/"):
+		ref, ok = spec.Components.Responses[name]
+	}
+	if !ok {
+		log.Fatalf("unresolved body reference %s", body.Ref)
+	}
+	return ref
+}
+
+// collectNames calls add with the key and the name of each property of a schema and of
+// its nested objects. The items of arrays and the values of maps have the key of their
+// parent, as their attributes have the path of their parent in the JSON names.
+func collectNames(spec oasSpec, schema *oasSchema, prefix string, visiting map[string]bool, add func(key, name string)) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		// Recursive schemas are walked once per path
+		if visiting[schema.Ref] {
+			return
+		}
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		ref, ok := spec.Components.Schemas[name]
+		if !ok {
+			log.Fatalf("unresolved schema reference %s", schema.Ref)
+		}
+		visiting[schema.Ref] = true
+		collectNames(spec, ref, prefix, visiting, add)
+		delete(visiting, schema.Ref)
+		return
+	}
+	for _, sub := range append(append(append([]*oasSchema{}, schema.AllOf...), schema.AnyOf...), schema.OneOf...) {
+		collectNames(spec, sub, prefix, visiting, add)
+	}
+	collectNames(spec, schema.Items, prefix, visiting, add)
+	if len(schema.AdditionalProperties) > 0 && schema.AdditionalProperties[0] == '{' {
+		values := &oasSchema{}
+		if err := json.Unmarshal(schema.AdditionalProperties, values); err != nil {
+			log.Fatalf("failed to parse additionalProperties: %v", err)
+		}
+		collectNames(spec, values, prefix, visiting, add)
+	}
+	for name, prop := range schema.Properties {
+		key := tfutils.NameKey(name)
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		add(key, name)
+		collectNames(spec, prop, key, visiting, add)
+	}
+}
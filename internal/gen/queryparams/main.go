@@ -0,0 +1,148 @@
+// Command queryparams generates the table of the query parameters of the API operations
+// from the OpenAPI specification, for tfutils.ModelToQueryValues.
+//
+// Usage:
+//
+//	go run ./internal/gen/queryparams -oas specs/oas.json -out internal/provider/query_params_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+type oasSchema struct {
+	Type string `json:"type"`
+}
+
+type oasParameter struct {
+	Ref     string     `json:"$ref"`
+	Name    string     `json:"name"`
+	In      string     `json:"in"`
+	Style   string     `json:"style"`
+	Explode *bool      `json:"explode"`
+	Schema  *oasSchema `json:"schema"`
+}
+
+type oasSpec struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Parameters map[string]oasParameter `json:"parameters"`
+	} `json:"components"`
+}
+
+type oasOperation struct {
+	Parameters []oasParameter `json:"parameters"`
+}
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+func main() {
+	oasFile := flag.String("oas", "specs/oas.json", "the OpenAPI specification")
+	outFile := flag.String("out", "query_params_gen.go", "the generated Go file")
+	pkg := flag.String("package", "provider", "the package of the generated Go file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*oasFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec := oasSpec{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		log.Fatalf("failed to parse %s: %v", *oasFile, err)
+	}
+
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by queryparams from %s. DO NOT EDIT.\n\n", strings.TrimLeft(*oasFile, "./"))
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+	fmt.Fprintf(&buf, "import \"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils\"\n\n")
+	fmt.Fprintf(&buf, "// oasQueryParamTable holds the query parameters of the API operations, by method and path.\n")
+	fmt.Fprintf(&buf, "var oasQueryParamTable = map[string][]tfutils.QueryParam{\n")
+	for _, path := range paths {
+		item := spec.Paths[path]
+		common := []oasParameter{}
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &common); err != nil {
+				log.Fatalf("failed to parse the parameters of %s: %v", path, err)
+			}
+		}
+		for _, method := range methods {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+			op := oasOperation{}
+			if err := json.Unmarshal(raw, &op); err != nil {
+				log.Fatalf("failed to parse %s %s: %v", method, path, err)
+			}
+			params := queryParams(spec, append(append([]oasParameter{}, common...), op.Parameters...))
+			if len(params) == 0 {
+				continue
+			}
+			fmt.Fprintf(&buf, "\t%q: {\n", strings.ToUpper(method)+" "+path)
+			for _, p := range params {
+				fmt.Fprintf(&buf, "\t\t{Name: %q, Type: %q, Style: %q, Explode: %t},\n", p.Name, p.Schema.Type, p.Style, *p.Explode)
+			}
+			fmt.Fprintf(&buf, "\t},\n")
+		}
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format the generated code: %v", err)
+	}
+	if err := os.WriteFile(*outFile, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// queryParams returns the query parameters of an operation sorted by name, with their
+// references resolved and the OpenAPI defaults applied. The parameters of the operation
+// override the ones of the path with the same name.
+func queryParams(spec oasSpec, params []oasParameter) []oasParameter {
+	byName := map[string]oasParameter{}
+	for _, p := range params {
+		if p.Ref != "" {
+			name := strings.TrimPrefix(p.Ref, "#/components/parameters/")
+			ref, ok := spec.Components.Parameters[name]
+			if !ok {
+				log.Fatalf("unresolved parameter reference %s", p.Ref)
+			}
+			p = ref
+		}
+		if p.In != "query" {
+			continue
+		}
+		if p.Schema == nil {
+			p.Schema = &oasSchema{Type: "string"}
+		}
+		if p.Style == "" {
+			p.Style = "form"
+		}
+		if p.Explode == nil {
+			explode := p.Style == "form"
+			p.Explode = &explode
+		}
+		byName[p.Name] = p
+	}
+	out := make([]oasParameter, 0, len(byName))
+	for _, p := range byName {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// operation is an API operation of a data source or a resource in specs/config.yml.
+type operation struct {
+	Path   string
+	Method string
+}
+
+// config holds the data sources and the resources of specs/config.yml, by name.
+type config struct {
+	DataSources map[string]map[string]operation
+	Resources   map[string]map[string]operation
+}
+
+// loadConfig reads the data sources and the resources of specs/config.yml.
+func loadConfig(file string) (config, error) {
+	tree, err := parseMappings(file)
+	if err != nil {
+		return config{}, err
+	}
+	cfg := config{}
+	if cfg.DataSources, err = operations(tree, "data_sources"); err != nil {
+		return config{}, fmt.Errorf("%s: %w", file, err)
+	}
+	if cfg.Resources, err = operations(tree, "resources"); err != nil {
+		return config{}, fmt.Errorf("%s: %w", file, err)
+	}
+	return cfg, nil
+}
+
+// operations returns the operations of the entries of the given section, by entry name
+// and by kind of operation, such as read or create.
+func operations(tree map[string]any, section string) (map[string]map[string]operation, error) {
+	entries, _ := tree[section].(map[string]any)
+	out := make(map[string]map[string]operation, len(entries))
+	for name, entry := range entries {
+		ops, ok := entry.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: expected a mapping", section, name)
+		}
+		out[name] = map[string]operation{}
+		for kind, op := range ops {
+			fields, ok := op.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s.%s.%s: expected a mapping", section, name, kind)
+			}
+			path, _ := fields["path"].(string)
+			method, _ := fields["method"].(string)
+			if path == "" || method == "" {
+				return nil, fmt.Errorf("%s.%s.%s: path and method are required", section, name, kind)
+			}
+			out[name][kind] = operation{Path: path, Method: strings.ToUpper(method)}
+		}
+	}
+	return out, nil
+}
+
+// parseMappings parses a YAML file whose top level is a mapping.
+func parseMappings(file string) (map[string]any, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	root := map[string]any{}
+	if err := yaml.Unmarshal(b, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return root, nil
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
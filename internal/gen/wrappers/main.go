@@ -0,0 +1,335 @@
+// Command wrappers generates the data sources and the resources of the provider that
+// specs/config.yml declares, from their operations in specs/oas.json and their models.
+//
+// Each data source reads its API operation, binding the path parameters to the attributes
+// of the same name and wrapping array responses into the list attribute named after the
+// data source. The fields of the response without an attribute are kept in the extra
+// attribute: a dynamic one next to the model for object responses, unless the model has
+// its own, and a JSON encoded one in each item for array responses. Each resource is
+// declared as a crudResource of the provider package, which maps its CRUD operations to
+// API operations, identifies its object by the path parameters of its read operation and
+// is imported from an ID made of those joined with slashes. The models are taken from the
+// internal/datasource_<name> and internal/resource_<name> packages, where a CUSTOM MODEL
+// <Name>CustomModel replaces the generated one, and applies the list options if it embeds
+// tfutils.ListOptionsModel. A resource uses the FieldModes of the variable <name>FieldModes
+// when the provider package declares it.
+//
+// The data sources and resources whose constructor is declared in a file of the provider
+// package that is not generated are left to that file, for the ones that need more than
+// the API operations of the config.
+//
+// Usage:
+//
+//	go run ./internal/gen/wrappers -config specs/config.yml -oas specs/oas.json -out internal/provider
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// generatedHeader starts the files written by this command, and only those are removed
+// when their data source or resource is gone.
+const generatedHeader = "// Code generated by wrappers from "
+
+type oasSchema struct {
+	Ref   string       `json:"$ref"`
+	Type  string       `json:"type"`
+	AllOf []*oasSchema `json:"allOf"`
+}
+
+type oasMediaType struct {
+	Schema *oasSchema `json:"schema"`
+}
+
+type oasBody struct {
+	Ref     string                  `json:"$ref"`
+	Content map[string]oasMediaType `json:"content"`
+}
+
+type oasOperation struct {
+	Responses map[string]oasBody `json:"responses"`
+}
+
+type oasSpec struct {
+	Paths      map[string]map[string]oasOperation `json:"paths"`
+	Components struct {
+		Schemas   map[string]*oasSchema `json:"schemas"`
+		Responses map[string]oasBody    `json:"responses"`
+	} `json:"components"`
+}
+
+// pathParam is a path parameter of an operation, bound to an attribute of the model.
+type pathParam struct {
+	// Key is the name of the parameter in the path constant.
+	Key string
+	// Attr and Field are the tfsdk and Go names of the attribute.
+	Attr, Field string
+}
+
+// boundOperation is an operation of the config with its path parameters bound to the model.
+type boundOperation struct {
+	Path   string
+	Params []pathParam
+}
+
+// wrapper holds what the templates need to generate a data source or a resource.
+type wrapper struct {
+	Source     string
+	Name       string
+	Camel      string
+	LowerCamel string
+	Package    string
+	Type       string
+	Model      *model
+	// List is true if the response of the read operation of a data source is an array.
+	List bool
+	// Extra is true if the response of the read operation of a data source is an object and
+	// its model has no extra attribute, which the wrapper then adds next to the model.
+	Extra                        bool
+	Create, Read, Update, Delete boundOperation
+	// ServerIDs are the read path parameters of a resource assigned by the API on creation,
+	// which are not create path parameters and are computed.
+	ServerIDs []pathParam
+	// FieldModes is the expression of the FieldModes of a resource.
+	FieldModes string
+	kind       string
+}
+
+// Const returns the name of the constant of the path of an operation, such as read_ds_alarms.
+func (w wrapper) Const(op string) string {
+	return op + "_" + w.kind + "_" + w.LowerCamel
+}
+
+func main() {
+	configFile := flag.String("config", "specs/config.yml", "the generator config of the provider")
+	oasFile := flag.String("oas", "specs/oas.json", "the OpenAPI specification")
+	outDir := flag.String("out", ".", "the directory of the provider package")
+	modelsDir := flag.String("models", "..", "the directory of the model packages")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data, err := os.ReadFile(*oasFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	spec := oasSpec{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		log.Fatalf("failed to parse %s: %v", *oasFile, err)
+	}
+	handWritten, err := parsePackage(*outDir, func(name string) bool { return strings.HasSuffix(name, "_gen.go") })
+	if err != nil {
+		log.Fatal(err)
+	}
+	source := strings.TrimLeft(filepath.ToSlash(*configFile), "./")
+
+	written := map[string]bool{}
+	var dataSources, resources []string
+	for _, name := range sortedKeys(cfg.DataSources) {
+		w := newWrapper(source, name, "ds")
+		dataSources = append(dataSources, w.Camel)
+		if handWritten.funcs["New"+w.Camel+"DataSource"] != nil {
+			continue
+		}
+		read, ok := cfg.DataSources[name]["read"]
+		if !ok {
+			log.Fatalf("data source %s: no read operation", name)
+		}
+		w.Package = "datasource_" + name
+		w.Type = w.LowerCamel + "DataSource"
+		if w.Model, err = loadModel(filepath.Join(*modelsDir, w.Package), w.Camel, "DataSource"); err != nil {
+			log.Fatalf("data source %s: %v", name, err)
+		}
+		w.Read = w.bind(read)
+		w.List = responseIsArray(spec, read)
+		w.Extra = !w.List && w.Model.fields[tfutils.ExtraAttribute] == ""
+		if w.Extra && w.Model.Target == "&data" {
+			w.Model.Target = "&data." + w.Model.Type
+		}
+		file := name + "_data_source_gen.go"
+		write(filepath.Join(*outDir, file), dataSourceTemplate, w)
+		written[file] = true
+	}
+	for _, name := range sortedKeys(cfg.Resources) {
+		w := newWrapper(source, name, "rs")
+		resources = append(resources, w.Camel)
+		if handWritten.funcs["New"+w.Camel+"Resource"] != nil {
+			continue
+		}
+		ops := cfg.Resources[name]
+		for _, kind := range []string{"create", "read", "update", "delete"} {
+			if _, ok := ops[kind]; !ok {
+				log.Fatalf("resource %s: no %s operation", name, kind)
+			}
+		}
+		w.Package = "resource_" + name
+		w.Type = w.LowerCamel + "Resource"
+		if w.Model, err = loadModel(filepath.Join(*modelsDir, w.Package), w.Camel, "Resource"); err != nil {
+			log.Fatalf("resource %s: %v", name, err)
+		}
+		w.Create, w.Read, w.Update, w.Delete = w.bind(ops["create"]), w.bind(ops["read"]), w.bind(ops["update"]), w.bind(ops["delete"])
+		for _, op := range []boundOperation{w.Create, w.Update, w.Delete} {
+			for _, p := range op.Params {
+				if !slices.Contains(w.Read.Params, p) {
+					log.Fatalf("resource %s: the path parameter %s of %s is not one of the read operation", name, p.Key, op.Path)
+				}
+			}
+		}
+		for _, p := range w.Read.Params {
+			if !slices.Contains(w.Create.Params, p) && w.Model.serverAssigned(p.Attr) {
+				w.ServerIDs = append(w.ServerIDs, p)
+			}
+		}
+		w.FieldModes = "nil"
+		if handWritten.vars[w.LowerCamel+"FieldModes"] {
+			w.FieldModes = w.LowerCamel + "FieldModes"
+		}
+		file := name + "_resource_gen.go"
+		write(filepath.Join(*outDir, file), resourceTemplate, w)
+		written[file] = true
+	}
+	write(filepath.Join(*outDir, "provider_gen.go"), providerTemplate, map[string]any{
+		"Source":      source,
+		"DataSources": dataSources,
+		"Resources":   resources,
+	})
+	removeStale(*outDir, written)
+}
+
+func newWrapper(source, name, kind string) wrapper {
+	camel := ""
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			camel += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return wrapper{
+		Source:     source,
+		Name:       name,
+		Camel:      camel,
+		LowerCamel: strings.ToLower(camel[:1]) + camel[1:],
+		kind:       kind,
+	}
+}
+
+var pathParamRe = regexp.MustCompile(`\{([^}]+)\}`)
+
+// bind returns the operation with the dashes of its path parameters replaced by underscores,
+// as they are Go identifiers of the API client, and its parameters bound to the model.
+func (w wrapper) bind(op operation) boundOperation {
+	bound := boundOperation{}
+	bound.Path = pathParamRe.ReplaceAllStringFunc(op.Path, func(p string) string {
+		key := strings.ReplaceAll(p[1:len(p)-1], "-", "_")
+		attr, field, ok := w.Model.field(key)
+		if !ok {
+			log.Fatalf("%s: no attribute of %s for the path parameter %s", w.Name, w.Model.Type, key)
+		}
+		bound.Params = append(bound.Params, pathParam{Key: key, Attr: attr, Field: field})
+		return "{" + key + "}"
+	})
+	return bound
+}
+
+// responseIsArray reports whether the successful response of the operation is an array.
+func responseIsArray(spec oasSpec, op operation) bool {
+	item, ok := spec.Paths[op.Path]
+	if !ok {
+		log.Fatalf("%s %s: path not found in the OpenAPI specification", op.Method, op.Path)
+	}
+	oasOp, ok := item[strings.ToLower(op.Method)]
+	if !ok {
+		log.Fatalf("%s %s: operation not found in the OpenAPI specification", op.Method, op.Path)
+	}
+	for _, code := range sortedKeys(oasOp.Responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		body := oasOp.Responses[code]
+		if body.Ref != "" {
+			body = spec.Components.Responses[body.Ref[strings.LastIndex(body.Ref, "/")+1:]]
+		}
+		if media, ok := body.Content["application/json"]; ok && media.Schema != nil {
+			return schemaType(spec, media.Schema) == "array"
+		}
+	}
+	return false
+}
+
+// schemaType returns the type of a schema, following its references.
+func schemaType(spec oasSpec, schema *oasSchema) string {
+	for schema != nil {
+		switch {
+		case schema.Type != "":
+			return schema.Type
+		case schema.Ref != "":
+			schema = spec.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+		case len(schema.AllOf) == 1:
+			schema = schema.AllOf[0]
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// nameKey matches path parameters with the attributes of the models.
+func nameKey(name string) string {
+	return tfutils.NameKey(name)
+}
+
+// write executes tmpl with data and writes the formatted result to file.
+func write(file string, tmpl *template.Template, data any) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Fatalf("failed to generate %s: %v", file, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format %s: %v\n%s", file, err, buf.Bytes())
+	}
+	if err := os.WriteFile(file, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// removeStale removes the wrappers generated by a previous run that were not written by
+// this one, as their data source or resource was removed from the config or is now
+// written by hand.
+func removeStale(dir string, written map[string]bool) {
+	for _, pattern := range []string{"*_data_source_gen.go", "*_resource_gen.go"} {
+		files, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, file := range files {
+			if written[filepath.Base(file)] {
+				continue
+			}
+			src, err := os.ReadFile(file)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if bytes.HasPrefix(src, []byte(generatedHeader)) {
+				if err := os.Remove(file); err != nil {
+					log.Fatal(err)
+				}
+				fmt.Println("removed", file)
+			}
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// goPackage holds the top level declarations of the Go files of a directory.
+type goPackage struct {
+	funcs   map[string]*ast.FuncDecl
+	structs map[string]*ast.StructType
+	vars    map[string]bool
+}
+
+// parsePackage parses the Go files of dir, except the test files and the ones skip returns true for.
+func parsePackage(dir string, skip func(name string) bool) (*goPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &goPackage{
+		funcs:   map[string]*ast.FuncDecl{},
+		structs: map[string]*ast.StructType{},
+		vars:    map[string]bool{},
+	}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || skip(name) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					pkg.funcs[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if st, ok := spec.Type.(*ast.StructType); ok {
+							pkg.structs[spec.Name.Name] = st
+						}
+					case *ast.ValueSpec:
+						for _, n := range spec.Names {
+							pkg.vars[n.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// model describes the model of a data source or a resource, generated or custom.
+type model struct {
+	// Type is the name of the model type, such as AlarmsModel or AlarmsCustomModel.
+	Type string
+	// Schema is the name of the function returning the schema.
+	Schema string
+	// Target is the expression of the model the API values are converted from and to,
+	// the generated model embedded in the custom model if any, or the model embedded in
+	// the model of the wrapper with the extra attribute.
+	Target string
+	// ListOptions is true if the custom model embeds tfutils.ListOptionsModel.
+	ListOptions bool
+	// fields are the Go names of the attributes of the model, by tfsdk name.
+	fields map[string]string
+	// schema is the function returning the schema, and genSchema the generated one.
+	schema, genSchema *ast.FuncDecl
+}
+
+// loadModel inspects the model package of a data source or a resource, where kind is
+// DataSource or Resource. A CUSTOM MODEL named <camel>CustomModel, with its schema function
+// <camel>Custom<kind>Schema, is used instead of the generated model when it is declared.
+func loadModel(dir, camel, kind string) (*model, error) {
+	pkg, err := parsePackage(dir, func(string) bool { return false })
+	if err != nil {
+		return nil, err
+	}
+	genType, customType := camel+"Model", camel+"CustomModel"
+	genSchema, customSchema := camel+kind+"Schema", camel+"Custom"+kind+"Schema"
+	m := &model{Type: genType, Schema: genSchema, Target: "&data", fields: map[string]string{}}
+	m.genSchema = pkg.funcs[genSchema]
+	if m.genSchema == nil {
+		return nil, fmt.Errorf("%s: %s not found", dir, genSchema)
+	}
+	st := pkg.structs[genType]
+	if custom, ok := pkg.structs[customType]; ok {
+		m.Type, st = customType, custom
+		for _, f := range custom.Fields.List {
+			if len(f.Names) > 0 {
+				continue
+			}
+			switch t := f.Type.(type) {
+			case *ast.Ident:
+				if t.Name == genType {
+					m.Target = "&data." + genType
+				}
+			case *ast.SelectorExpr:
+				if t.Sel.Name == "ListOptionsModel" {
+					m.ListOptions = true
+				}
+			}
+		}
+		if _, ok := pkg.funcs[customSchema]; ok {
+			m.Schema = customSchema
+		}
+	}
+	if st == nil {
+		return nil, fmt.Errorf("%s: %s not found", dir, genType)
+	}
+	m.schema = pkg.funcs[m.Schema]
+	m.addFields(pkg, st)
+	return m, nil
+}
+
+// addFields adds the attribute fields of st to the fields of m, including the fields of
+// the embedded structs of the package.
+func (m *model) addFields(pkg *goPackage, st *ast.StructType) {
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			if t, ok := f.Type.(*ast.Ident); ok && pkg.structs[t.Name] != nil {
+				m.addFields(pkg, pkg.structs[t.Name])
+			}
+			continue
+		}
+		if f.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		if name := reflect.StructTag(tag).Get("tfsdk"); name != "" {
+			m.fields[name] = f.Names[0].Name
+		}
+	}
+}
+
+// field returns the tfsdk name and the Go name of the attribute bound to a path parameter,
+// which are matched by nameKey.
+func (m *model) field(param string) (string, string, bool) {
+	for name, goName := range m.fields {
+		if nameKey(name) == nameKey(param) {
+			return name, goName, true
+		}
+	}
+	return "", "", false
+}
+
+// serverAssigned reports whether the top level attribute name of the schema is computed
+// and not required, so that its value is assigned by the API on creation.
+func (m *model) serverAssigned(name string) bool {
+	attrs := schemaAttributes(m.schema)
+	if attrs == nil {
+		// Custom schemas derived from the generated one have the same attribute
+		attrs = schemaAttributes(m.genSchema)
+	}
+	attr, ok := attrs[name]
+	if !ok {
+		return false
+	}
+	flags := map[string]bool{}
+	for _, elt := range attr.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, kok := kv.Key.(*ast.Ident)
+		val, vok := kv.Value.(*ast.Ident)
+		if kok && vok {
+			flags[key.Name] = val.Name == "true"
+		}
+	}
+	return flags["Computed"] && !flags["Required"]
+}
+
+// schemaAttributes returns the top level attributes of the schema returned by fn as a
+// composite literal, or nil if fn builds the schema otherwise.
+func schemaAttributes(fn *ast.FuncDecl) map[string]*ast.CompositeLit {
+	if fn == nil || fn.Body == nil {
+		return nil
+	}
+	for _, stmt := range fn.Body.List {
+		ret, ok := stmt.(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			continue
+		}
+		lit, ok := ret.Results[0].(*ast.CompositeLit)
+		if !ok {
+			return nil
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if key, kok := kv.Key.(*ast.Ident); !ok || !kok || key.Name != "Attributes" {
+				continue
+			}
+			attrsLit, ok := kv.Value.(*ast.CompositeLit)
+			if !ok {
+				return nil
+			}
+			attrs := map[string]*ast.CompositeLit{}
+			for _, a := range attrsLit.Elts {
+				akv, ok := a.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, kok := akv.Key.(*ast.BasicLit)
+				val, vok := akv.Value.(*ast.CompositeLit)
+				if !kok || !vok {
+					continue
+				}
+				if name, err := strconv.Unquote(key.Value); err == nil {
+					attrs[name] = val
+				}
+			}
+			return attrs
+		}
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package main
+
+import "text/template"
+
+// dataSourceTemplate is the wrapper of a data source, which reads its API operation
+// into its model.
+var dataSourceTemplate = template.Must(template.New("dataSource").Parse(`// Code generated by wrappers from {{.Source}}. DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/{{.Package}}"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const {{.Const "read"}} = "{{.Read.Path}}"
+
+var (
+	_ datasource.DataSource              = (*{{.Type}})(nil)
+	_ datasource.DataSourceWithConfigure = (*{{.Type}})(nil)
+)
+
+func New{{.Camel}}DataSource() datasource.DataSource {
+	return &{{.Type}}{}
+}
+
+type {{.Type}} struct {
+	providerData
+}
+{{- if .Extra}}
+
+// {{.Type}}Model is the model of the data source with the extra attribute.
+type {{.Type}}Model struct {
+	{{.Package}}.{{.Model.Type}}
+	tfutils.ExtraModel
+}
+{{- end}}
+
+func (d *{{.Type}}) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_{{.Name}}"
+}
+
+func (d *{{.Type}}) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+{{- if .Extra}}
+	resp.Schema = tfutils.AddExtraAttribute({{.Package}}.{{.Model.Schema}}(ctx))
+{{- else if .List}}
+	resp.Schema = tfutils.AddItemExtraAttribute({{.Package}}.{{.Model.Schema}}(ctx), "{{.Name}}")
+{{- else}}
+	resp.Schema = {{.Package}}.{{.Model.Schema}}(ctx)
+{{- end}}
+}
+
+func (d *{{.Type}}) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+{{- if .Extra}}
+	var data {{.Type}}Model
+{{- else}}
+	var data {{.Package}}.{{.Model.Type}}
+{{- end}}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Extract query params from Terraform model
+	queryParams, err := tfutils.ModelToQueryValues(ctx, {{.Model.Target}}, oasQueryParams(http.MethodGet, {{.Const "read"}}))
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting query params", err.Error())
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path":  {{.Const "read"}},
+		"data":  spew.Sdump(data),
+		"query": queryParams,
+	})
+
+	t0 := time.Now()
+	result := {{if .List}}[]any{}{{else}}map[string]any{}{{end}}
+	err = d.client.GetByQuery(ctx, {{.Const "read"}}, {{template "params" .Read.Params}}, queryParams, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      {{.Const "read"}},
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+{{- if .Model.ListOptions}}
+
+	// Apply the client-side list options
+	items, err := data.Apply(ctx, oasConverter(http.MethodGet, {{.Const "read"}}, "{{.LowerCamel}}"), "{{.LowerCamel}}", result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error applying list options", err.Error())
+		return
+	}
+{{- end}}
+{{- if .List}}
+
+	newResult := map[string]any{
+		"{{.LowerCamel}}": {{if .Model.ListOptions}}items{{else}}result{{end}},
+	}
+
+	// Convert API response to Terraform model
+	err = d.oasDecoder(http.MethodGet, {{.Const "read"}}, "{{.LowerCamel}}").AnyMapToModel(ctx, newResult, {{.Model.Target}})
+{{- else}}
+
+	// Convert API response to Terraform model
+	decoder := d.oasDecoder(http.MethodGet, {{.Const "read"}})
+	err = decoder.AnyMapToModel(ctx, result, {{.Model.Target}})
+{{- end}}
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+{{- if .Extra}}
+	data.Extra, err = decoder.ExtraValue(result, {{.Model.Target}})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+		return
+	}
+{{- end}}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *{{.Type}}) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
+{{define "params"}}{{if .}}map[string]string{
+{{- range .}}
+		"{{.Key}}": tfutils.StringValue(data.{{.Field}}),
+{{- end}}
+	}{{else}}nil{{end}}{{end}}
+`))
+
+// resourceTemplate is the declaration of a resource as a crudResource, whose CRUD operations
+// are API operations. The resources whose identity is assigned by the API take the values of
+// the create and update responses, the others are read again once created or updated.
+var resourceTemplate = template.Must(template.New("resource").Parse(`// Code generated by wrappers from {{.Source}}. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/{{.Package}}"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	{{.Const "create"}} = "{{.Create.Path}}"
+	{{.Const "read"}} = "{{.Read.Path}}"
+	{{.Const "update"}} = "{{.Update.Path}}"
+	{{.Const "delete"}} = "{{.Delete.Path}}"
+)
+
+func New{{.Camel}}Resource() resource.Resource {
+	return &crudResource[{{.Package}}.{{.Model.Type}}]{
+		typeName: "_{{.Name}}",
+		schema:   {{.Package}}.{{.Model.Schema}},
+		paths: crudPaths{
+			Create: {{.Const "create"}},
+			Read:   {{.Const "read"}},
+			Update: {{.Const "update"}},
+			Delete: {{.Const "delete"}},
+		},
+		identity: func(data *{{.Package}}.{{.Model.Type}}) map[string]string {
+			return map[string]string{
+{{- range .Read.Params}}
+				"{{.Key}}": tfutils.StringValue(data.{{.Field}}),
+{{- end}}
+			}
+		},
+{{- if ne .Model.Target "&data"}}
+		target: func(data *{{.Package}}.{{.Model.Type}}) any {
+			return {{.Model.Target}}
+		},
+{{- end}}
+{{- if ne .FieldModes "nil"}}
+		fieldModes: {{.FieldModes}},
+{{- end}}
+{{- if .ServerIDs}}
+		keepState: func(data, state *{{.Package}}.{{.Model.Type}}) {
+{{- range .ServerIDs}}
+			data.{{.Field}} = state.{{.Field}}
+{{- end}}
+		},
+{{- else}}
+		reread: true,
+{{- end}}
+		parseImportID: splitImportID({{range $i, $p := .Read.Params}}{{if $i}}, {{end}}"{{$p.Attr}}"{{end}}),
+	}
+}
+`))
+
+// providerTemplate lists the constructors of the data sources and the resources of the config.
+var providerTemplate = template.Must(template.New("provider").Parse(`// Code generated by wrappers from {{.Source}}. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// configDataSources are the data sources declared in {{.Source}}.
+var configDataSources = []func() datasource.DataSource{
+{{- range .DataSources}}
+	New{{.}}DataSource,
+{{- end}}
+}
+
+// configResources are the resources declared in {{.Source}}.
+var configResources = []func() resource.Resource{
+{{- range .Resources}}
+	New{{.}}Resource,
+{{- end}}
+}
+`))
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_access_check"
+)
+
+const read_ds_accessCheck = "/core/access/v1/checkaccess"
+
+var (
+	_ datasource.DataSource              = (*accessCheckDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*accessCheckDataSource)(nil)
+)
+
+func NewAccessCheckDataSource() datasource.DataSource {
+	return &accessCheckDataSource{}
+}
+
+type accessCheckDataSource struct {
+	providerData
+}
+
+func (d *accessCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_check"
+}
+
+func (d *accessCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_access_check.AccessCheckDataSourceSchema(ctx)
+}
+
+func (d *accessCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_access_check.AccessCheckModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rules []datasource_access_check.ResourceRuleModel
+	resp.Diagnostics.Append(data.ResourceRules.ElementsAs(ctx, &rules, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, reqBody, diags := accessCheckQueries(ctx, rules)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": read_ds_accessCheck,
+		"body": spew.Sdump(reqBody),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+	err := d.client.Create(ctx, read_ds_accessCheck, nil, reqBody, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      read_ds_accessCheck,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// A denied check is reported in the result rather than as an error,
+	// so that it can be checked with preconditions and check blocks.
+	allowed := true
+	for i := range results {
+		access, errMsg := accessCheckResult(result[strconv.Itoa(i)])
+		results[i].Access = types.StringValue(access)
+		results[i].Error = types.StringValue(errMsg)
+		results[i].Allowed = types.BoolValue(accessAllows(access, results[i].Permissions.ValueString()))
+		allowed = allowed && results[i].Allowed.ValueBool()
+	}
+
+	resultsVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_access_check.ResultAttrTypes}, results)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Results = resultsVal
+	data.Allowed = types.BoolValue(allowed)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// accessCheckQueries expands the rules into one gvr query per api group and resource, keyed
+// by the index of the query in the returned results. An api group without a version is
+// queried with an empty version, and a rule without a namespace for cluster-wide access.
+func accessCheckQueries(ctx context.Context, rules []datasource_access_check.ResourceRuleModel) ([]datasource_access_check.ResultModel, map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	results := []datasource_access_check.ResultModel{}
+	reqBody := map[string]any{}
+	for _, rule := range rules {
+		var apiGroups, resources []string
+		diags.Append(rule.ApiGroups.ElementsAs(ctx, &apiGroups, false)...)
+		diags.Append(rule.Resources.ElementsAs(ctx, &resources, false)...)
+
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		namespace := rule.Namespace.ValueString()
+		for _, apiGroup := range apiGroups {
+			group, version, _ := strings.Cut(apiGroup, "/")
+			for _, resource := range resources {
+				reqBody[strconv.Itoa(len(results))] = map[string]any{
+					"type":      "gvr",
+					"namespace": namespace,
+					"gvr": map[string]any{
+						"group":    group,
+						"version":  version,
+						"resource": resource,
+					},
+				}
+				results = append(results, datasource_access_check.ResultModel{
+					ApiGroup:    types.StringValue(apiGroup),
+					Namespace:   types.StringValue(namespace),
+					Permissions: rule.Permissions,
+					Resource:    types.StringValue(resource),
+				})
+			}
+		}
+	}
+	return results, reqBody, diags
+}
+
+// accessCheckResult returns the access level and error message of a single AccessResult.
+// A missing result is treated as no access.
+func accessCheckResult(anyVal any) (string, string) {
+	res, _ := anyVal.(map[string]any)
+	access, _ := res["access"].(string)
+	if access == "" {
+		access = "none"
+	}
+	errMsg := ""
+	if errRes, ok := res["error"].(map[string]any); ok {
+		errMsg, _ = errRes["message"].(string)
+	}
+	return access, errMsg
+}
+
+// accessAllows reports whether the granted access level covers the requested permissions.
+// readPropose only allows changes through a merge request, so it does not cover readWrite.
+func accessAllows(access, permissions string) bool {
+	switch permissions {
+	case "read":
+		return access == "read" || access == "readWrite" || access == "readPropose"
+	case "readWrite":
+		return access == "readWrite"
+	}
+	return false
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *accessCheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
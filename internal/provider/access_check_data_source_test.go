@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_access_check"
+)
+
+func TestAccessAllows(t *testing.T) {
+	tests := []struct {
+		access      string
+		permissions string
+		want        bool
+	}{
+		{access: "read", permissions: "read", want: true},
+		{access: "readWrite", permissions: "read", want: true},
+		{access: "readPropose", permissions: "read", want: true},
+		{access: "none", permissions: "read", want: false},
+		{access: "readWrite", permissions: "readWrite", want: true},
+		{access: "readPropose", permissions: "readWrite", want: false},
+		{access: "read", permissions: "readWrite", want: false},
+		{access: "none", permissions: "readWrite", want: false},
+		{access: "readWrite", permissions: "write", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.access+" for "+tt.permissions, func(t *testing.T) {
+			if got := accessAllows(tt.access, tt.permissions); got != tt.want {
+				t.Errorf("accessAllows(%q, %q) = %v, want %v", tt.access, tt.permissions, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessCheckResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     any
+		wantAccess string
+		wantError  string
+	}{
+		{
+			name:       "allowed",
+			result:     map[string]any{"access": "readWrite"},
+			wantAccess: "readWrite",
+		},
+		{
+			name:       "missing result",
+			result:     nil,
+			wantAccess: "none",
+		},
+		{
+			name:       "empty access",
+			result:     map[string]any{"access": ""},
+			wantAccess: "none",
+		},
+		{
+			name: "error result",
+			result: map[string]any{
+				"error": map[string]any{"code": float64(400), "message": "unknown resource interfaces"},
+			},
+			wantAccess: "none",
+			wantError:  "unknown resource interfaces",
+		},
+		{
+			name:       "not an object",
+			result:     "readWrite",
+			wantAccess: "none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, errMsg := accessCheckResult(tt.result)
+			if access != tt.wantAccess || errMsg != tt.wantError {
+				t.Errorf("accessCheckResult() = (%q, %q), want (%q, %q)", access, errMsg, tt.wantAccess, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAccessCheckQueries(t *testing.T) {
+	ctx := context.Background()
+	list := func(values ...string) types.List {
+		l, _ := types.ListValueFrom(ctx, types.StringType, values)
+		return l
+	}
+
+	tests := []struct {
+		name        string
+		rules       []datasource_access_check.ResourceRuleModel
+		wantBody    map[string]any
+		wantResults []datasource_access_check.ResultModel
+	}{
+		{
+			name: "api groups and resources are expanded",
+			rules: []datasource_access_check.ResourceRuleModel{{
+				ApiGroups:   list("interfaces.eda.nokia.com/v1alpha1"),
+				Namespace:   types.StringValue("eda"),
+				Permissions: types.StringValue("readWrite"),
+				Resources:   list("interfaces", "breakouts"),
+			}},
+			wantBody: map[string]any{
+				"0": map[string]any{
+					"type":      "gvr",
+					"namespace": "eda",
+					"gvr":       map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "resource": "interfaces"},
+				},
+				"1": map[string]any{
+					"type":      "gvr",
+					"namespace": "eda",
+					"gvr":       map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "resource": "breakouts"},
+				},
+			},
+			wantResults: []datasource_access_check.ResultModel{
+				{
+					ApiGroup:    types.StringValue("interfaces.eda.nokia.com/v1alpha1"),
+					Namespace:   types.StringValue("eda"),
+					Permissions: types.StringValue("readWrite"),
+					Resource:    types.StringValue("interfaces"),
+				},
+				{
+					ApiGroup:    types.StringValue("interfaces.eda.nokia.com/v1alpha1"),
+					Namespace:   types.StringValue("eda"),
+					Permissions: types.StringValue("readWrite"),
+					Resource:    types.StringValue("breakouts"),
+				},
+			},
+		},
+		{
+			name: "cluster-wide api group without a version",
+			rules: []datasource_access_check.ResourceRuleModel{{
+				ApiGroups:   list("core.eda.nokia.com"),
+				Namespace:   types.StringNull(),
+				Permissions: types.StringValue("read"),
+				Resources:   list("namespaces"),
+			}},
+			wantBody: map[string]any{
+				"0": map[string]any{
+					"type":      "gvr",
+					"namespace": "",
+					"gvr":       map[string]any{"group": "core.eda.nokia.com", "version": "", "resource": "namespaces"},
+				},
+			},
+			wantResults: []datasource_access_check.ResultModel{{
+				ApiGroup:    types.StringValue("core.eda.nokia.com"),
+				Namespace:   types.StringValue(""),
+				Permissions: types.StringValue("read"),
+				Resource:    types.StringValue("namespaces"),
+			}},
+		},
+		{
+			name: "queries of several rules are numbered in order",
+			rules: []datasource_access_check.ResourceRuleModel{
+				{
+					ApiGroups:   list("interfaces.eda.nokia.com/v1alpha1"),
+					Namespace:   types.StringValue("eda"),
+					Permissions: types.StringValue("read"),
+					Resources:   list("interfaces"),
+				},
+				{
+					ApiGroups:   list("core.eda.nokia.com/v1"),
+					Namespace:   types.StringNull(),
+					Permissions: types.StringValue("readWrite"),
+					Resources:   list("namespaces"),
+				},
+			},
+			wantBody: map[string]any{
+				"0": map[string]any{
+					"type":      "gvr",
+					"namespace": "eda",
+					"gvr":       map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "resource": "interfaces"},
+				},
+				"1": map[string]any{
+					"type":      "gvr",
+					"namespace": "",
+					"gvr":       map[string]any{"group": "core.eda.nokia.com", "version": "v1", "resource": "namespaces"},
+				},
+			},
+			wantResults: []datasource_access_check.ResultModel{
+				{
+					ApiGroup:    types.StringValue("interfaces.eda.nokia.com/v1alpha1"),
+					Namespace:   types.StringValue("eda"),
+					Permissions: types.StringValue("read"),
+					Resource:    types.StringValue("interfaces"),
+				},
+				{
+					ApiGroup:    types.StringValue("core.eda.nokia.com/v1"),
+					Namespace:   types.StringValue(""),
+					Permissions: types.StringValue("readWrite"),
+					Resource:    types.StringValue("namespaces"),
+				},
+			},
+		},
+		{
+			name:        "no rules",
+			wantBody:    map[string]any{},
+			wantResults: []datasource_access_check.ResultModel{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, body, diags := accessCheckQueries(ctx, tt.rules)
+			if diags.HasError() {
+				t.Fatalf("accessCheckQueries() diagnostics = %v", diags)
+			}
+			if !reflect.DeepEqual(body, tt.wantBody) {
+				t.Errorf("accessCheckQueries() body = %v, want %v", body, tt.wantBody)
+			}
+			if !reflect.DeepEqual(results, tt.wantResults) {
+				t.Errorf("accessCheckQueries() results = %v, want %v", results, tt.wantResults)
+			}
+		})
+	}
+}
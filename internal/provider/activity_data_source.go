@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_activity"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+)
+
+const read_ds_activity = "/core/about/activity"
+
+var (
+	_ datasource.DataSource              = (*activityDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*activityDataSource)(nil)
+)
+
+func NewActivityDataSource() datasource.DataSource {
+	return &activityDataSource{}
+}
+
+type activityDataSource struct {
+	providerData
+}
+
+func (d *activityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity"
+}
+
+func (d *activityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_activity.ActivityDataSourceSchema(ctx)
+}
+
+func (d *activityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_activity.ActivityModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": read_ds_activity,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+	err := d.client.Get(ctx, read_ds_activity, nil, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      read_ds_activity,
+		"error":     err,
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	// There is no body, the activity is only reported through the HTTP status
+	var apiErr *apiclient.ApiError
+	switch {
+	case err == nil:
+		data.Active = types.BoolValue(true)
+		data.Mode = types.StringValue("ACTIVE")
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusServiceUnavailable:
+		data.Active = types.BoolValue(false)
+		data.Mode = types.StringValue("STANDBY")
+	default:
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *activityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/rest"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_alarm_acknowledgement"
+)
+
+const (
+	rs_clusterAlarms        = "/core/alarm/v2/alarms"
+	rs_clusterAlarm         = "/core/alarm/v2/alarms/{alarm-name}"
+	rs_namespaceAlarms      = "/core/alarm/v2/namespaces/{nsName}/alarms"
+	rs_namespaceAlarm       = "/core/alarm/v2/namespaces/{nsName}/alarms/{alarm-name}"
+	alarmActionSuppress     = "suppress"
+	alarmActionUndoPrefix   = "un"
+	alarmAcknowledgedField  = "acknowledged"
+	alarmSuppressedField    = "suppressed"
+	alarmAcknowledgeFields  = "name,namespace,acknowledged,suppressed"
+	alarmAcknowledgeIdDelim = "/"
+)
+
+var (
+	_ resource.Resource               = (*alarmAcknowledgementResource)(nil)
+	_ resource.ResourceWithConfigure  = (*alarmAcknowledgementResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*alarmAcknowledgementResource)(nil)
+)
+
+func NewAlarmAcknowledgementResource() resource.Resource {
+	return &alarmAcknowledgementResource{}
+}
+
+type alarmAcknowledgementResource struct {
+	providerData
+}
+
+// alarmRef identifies an alarm in the body of the bulk alarm requests.
+type alarmRef struct {
+	Name      string `json:"name" tfsdk:"name"`
+	Namespace string `json:"namespace,omitempty" tfsdk:"namespace"`
+}
+
+func (r *alarmAcknowledgementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alarm_acknowledgement"
+}
+
+func (r *alarmAcknowledgementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_alarm_acknowledgement.AlarmAcknowledgementResourceSchema(ctx)
+}
+
+func (r *alarmAcknowledgementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_alarm_acknowledgement.AlarmAcknowledgementModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the alarms to act on
+	var alarms []alarmRef
+	if !data.AlarmName.IsNull() {
+		alarms = []alarmRef{{Name: data.AlarmName.ValueString(), Namespace: data.Namespace.ValueString()}}
+	} else {
+		var err error
+		alarms, err = r.findAlarms(ctx, &data, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading alarms", err.Error())
+			return
+		}
+	}
+
+	err := r.applyAction(ctx, &data, data.Action.ValueString(), alarms)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", err.Error())
+		return
+	}
+
+	id := data.Namespace.ValueString() + alarmAcknowledgeIdDelim + data.AlarmName.ValueString()
+	if data.AlarmName.IsNull() {
+		id = data.Namespace.ValueString() + alarmAcknowledgeIdDelim + data.Filter.ValueString()
+	}
+	data.Id = types.StringValue(id)
+
+	resp.Diagnostics.Append(setAlarmRefs(ctx, &data, alarms)...)
+
+	// Save created data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *alarmAcknowledgementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_alarm_acknowledgement.AlarmAcknowledgementModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var alarms []alarmRef
+	if !data.AlarmName.IsNull() {
+		alarm, err := r.getAlarm(ctx, &data)
+		if apiclient.IsNotFound(err) {
+			// The alarm no longer exists, so there is nothing left to acknowledge
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading resource", err.Error())
+			return
+		}
+		if alarmInState(alarm, data.Action.ValueString()) {
+			alarms = []alarmRef{{Name: data.AlarmName.ValueString(), Namespace: data.Namespace.ValueString()}}
+		}
+	} else {
+		var err error
+		alarms, err = r.findAlarms(ctx, &data, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading resource", err.Error())
+			return
+		}
+	}
+
+	// A single alarm that was unacknowledged or unsuppressed outside of Terraform,
+	// or whose action expired, is planned for creation again.
+	if !data.AlarmName.IsNull() && len(alarms) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setAlarmRefs(ctx, &data, alarms)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *alarmAcknowledgementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state resource_alarm_acknowledgement.AlarmAcknowledgementModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only duration and delete_on_destroy can change in place, so the action is applied
+	// again with the new duration. A filter is resolved again, so that the action is also
+	// applied to the alarms that match it without the action in effect.
+	inEffect, diags := getAlarmRefs(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms := inEffect
+	if data.AlarmName.IsNull() {
+		var err error
+		alarms, err = r.findAlarms(ctx, &data, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading alarms", err.Error())
+			return
+		}
+	}
+
+	pending := alarms
+	if data.Duration.Equal(state.Duration) {
+		pending = missingAlarms(alarms, inEffect)
+	}
+	err := r.applyAction(ctx, &data, data.Action.ValueString(), pending)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating resource", err.Error())
+		return
+	}
+
+	data.Id = state.Id
+	resp.Diagnostics.Append(setAlarmRefs(ctx, &data, alarms)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *alarmAcknowledgementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_alarm_acknowledgement.AlarmAcknowledgementModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms, diags := getAlarmRefs(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(alarms) == 0 {
+		return
+	}
+
+	// Revert the action, e.g. unacknowledge an acknowledged alarm
+	err := r.applyAction(ctx, &data, alarmActionUndoPrefix+data.Action.ValueString(), alarms)
+	if err != nil && !apiclient.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting resource", err.Error())
+		return
+	}
+
+	if !data.DeleteOnDestroy.ValueBool() {
+		return
+	}
+
+	path, pathParams := alarmsPath(&data)
+
+	tflog.Info(ctx, "Delete()::API request", map[string]any{
+		"path":   path,
+		"alarms": spew.Sdump(alarms),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err = r.client.Execute(ctx, path, rest.HTTP_DELETE, pathParams, nil, alarms, &result)
+
+	tflog.Info(ctx, "Delete()::API returned", map[string]any{
+		"path":      path,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil && !apiclient.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting resource", err.Error())
+		return
+	}
+}
+
+// ModifyPlan resolves the filter of an existing acknowledgement again, and plans the action
+// if alarms match it on which the action is not in effect, which shows as a change of alarms.
+func (r *alarmAcknowledgementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on create or destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state resource_alarm_acknowledgement.AlarmAcknowledgementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Filter.IsNull() || plan.Filter.IsUnknown() || plan.Namespace.IsUnknown() {
+		return
+	}
+
+	inEffect, diags := getAlarmRefs(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms, err := r.findAlarms(ctx, &plan, false)
+	if err != nil {
+		// The alarms are resolved again on the next plan
+		resp.Diagnostics.AddWarning("Unable to resolve the alarms of the filter", err.Error())
+		return
+	}
+
+	if len(missingAlarms(alarms, inEffect)) > 0 {
+		elemType := types.ObjectType{AttrTypes: resource_alarm_acknowledgement.AlarmAttrTypes}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("alarms"), types.ListUnknown(elemType))...)
+	}
+}
+
+// missingAlarms returns the alarms that are not in inEffect.
+func missingAlarms(alarms, inEffect []alarmRef) []alarmRef {
+	missing := []alarmRef{}
+	for _, a := range alarms {
+		if !slices.Contains(inEffect, a) {
+			missing = append(missing, a)
+		}
+	}
+	return missing
+}
+
+// applyAction performs an acknowledgement action on the given alarms in a single request.
+func (r *alarmAcknowledgementResource) applyAction(ctx context.Context,
+	data *resource_alarm_acknowledgement.AlarmAcknowledgementModel, action string, alarms []alarmRef) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+	path, pathParams := alarmsPath(data)
+	queryParams := url.Values{"action": {action}}
+	if !data.Duration.IsNull() && action == data.Action.ValueString() {
+		queryParams.Set("duration", strconv.FormatInt(data.Duration.ValueInt64(), 10))
+	}
+
+	tflog.Info(ctx, "applyAction()::API request", map[string]any{
+		"path":   path,
+		"query":  queryParams,
+		"alarms": spew.Sdump(alarms),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.UpdateByQuery(ctx, path, pathParams, queryParams, alarms, &result)
+
+	tflog.Info(ctx, "applyAction()::API returned", map[string]any{
+		"path":      path,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	return err
+}
+
+// getAlarm returns a single alarm, including it if suppressed.
+func (r *alarmAcknowledgementResource) getAlarm(ctx context.Context,
+	data *resource_alarm_acknowledgement.AlarmAcknowledgementModel) (map[string]any, error) {
+	path := rs_clusterAlarm
+	pathParams := map[string]string{"alarm-name": data.AlarmName.ValueString()}
+	if !data.Namespace.IsNull() {
+		path = rs_namespaceAlarm
+		pathParams["nsName"] = data.Namespace.ValueString()
+	}
+
+	tflog.Info(ctx, "getAlarm()::API request", map[string]any{
+		"path":       path,
+		"pathParams": pathParams,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.GetByQuery(ctx, path, pathParams, url.Values{"all": {"true"}}, &result)
+
+	tflog.Info(ctx, "getAlarm()::API returned", map[string]any{
+		"path":      path,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	return result, err
+}
+
+// findAlarms returns the alarms matching the filter. If inState is true, only the
+// alarms on which the configured action is currently in effect are returned.
+func (r *alarmAcknowledgementResource) findAlarms(ctx context.Context,
+	data *resource_alarm_acknowledgement.AlarmAcknowledgementModel, inState bool) ([]alarmRef, error) {
+	path, pathParams := alarmsPath(data)
+	queryParams := url.Values{
+		"all":    {"true"},
+		"fields": {alarmAcknowledgeFields},
+		"filter": {data.Filter.ValueString()},
+	}
+
+	tflog.Info(ctx, "findAlarms()::API request", map[string]any{
+		"path":  path,
+		"query": queryParams,
+	})
+
+	t0 := time.Now()
+	result := []any{}
+
+	err := r.client.GetByQuery(ctx, path, pathParams, queryParams, &result)
+
+	tflog.Info(ctx, "findAlarms()::API returned", map[string]any{
+		"path":      path,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	alarms := []alarmRef{}
+	for _, item := range result {
+		alarm, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected alarm object, got %T", item)
+		}
+		if inState && !alarmInState(alarm, data.Action.ValueString()) {
+			continue
+		}
+		name, _ := alarm["name"].(string)
+		namespace, _ := alarm["namespace"].(string)
+		alarms = append(alarms, alarmRef{Name: name, Namespace: namespace})
+	}
+	return alarms, nil
+}
+
+// alarmsPath returns the bulk alarms path, namespaced if a namespace is configured.
+func alarmsPath(data *resource_alarm_acknowledgement.AlarmAcknowledgementModel) (string, map[string]string) {
+	if data.Namespace.IsNull() {
+		return rs_clusterAlarms, nil
+	}
+	return rs_namespaceAlarms, map[string]string{"nsName": data.Namespace.ValueString()}
+}
+
+// alarmInState reports whether the given action is currently in effect on the alarm.
+func alarmInState(alarm map[string]any, action string) bool {
+	field := alarmAcknowledgedField
+	if action == alarmActionSuppress {
+		field = alarmSuppressedField
+	}
+	inState, _ := alarm[field].(bool)
+	return inState
+}
+
+func setAlarmRefs(ctx context.Context, data *resource_alarm_acknowledgement.AlarmAcknowledgementModel, alarms []alarmRef) diag.Diagnostics {
+	elemType := types.ObjectType{AttrTypes: resource_alarm_acknowledgement.AlarmAttrTypes}
+	elems := make([]attr.Value, 0, len(alarms))
+	for _, a := range alarms {
+		elems = append(elems, types.ObjectValueMust(resource_alarm_acknowledgement.AlarmAttrTypes, map[string]attr.Value{
+			"name":      types.StringValue(a.Name),
+			"namespace": types.StringValue(a.Namespace),
+		}))
+	}
+	list, diags := types.ListValue(elemType, elems)
+	data.Alarms = list
+	return diags
+}
+
+func getAlarmRefs(ctx context.Context, data *resource_alarm_acknowledgement.AlarmAcknowledgementModel) ([]alarmRef, diag.Diagnostics) {
+	alarms := []alarmRef{}
+	if data.Alarms.IsNull() || data.Alarms.IsUnknown() {
+		return alarms, nil
+	}
+	diags := data.Alarms.ElementsAs(ctx, &alarms, false)
+	return alarms, diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alarmAcknowledgementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_alarm_acknowledgement"
+)
+
+func TestMissingAlarms(t *testing.T) {
+	a := alarmRef{Name: "InterfaceDown-leaf1-ethernet-1-1", Namespace: "eda"}
+	b := alarmRef{Name: "InterfaceDown-leaf1-ethernet-1-2", Namespace: "eda"}
+	otherNs := alarmRef{Name: "InterfaceDown-leaf1-ethernet-1-1", Namespace: "lab"}
+
+	tests := []struct {
+		name     string
+		alarms   []alarmRef
+		inEffect []alarmRef
+		want     []alarmRef
+	}{
+		{
+			name:     "all in effect",
+			alarms:   []alarmRef{a, b},
+			inEffect: []alarmRef{a, b},
+			want:     []alarmRef{},
+		},
+		{
+			name:     "new alarm matching the filter",
+			alarms:   []alarmRef{a, b},
+			inEffect: []alarmRef{a},
+			want:     []alarmRef{b},
+		},
+		{
+			name:     "cleared alarm",
+			alarms:   []alarmRef{b},
+			inEffect: []alarmRef{a, b},
+			want:     []alarmRef{},
+		},
+		{
+			name:     "same name in another namespace",
+			alarms:   []alarmRef{otherNs},
+			inEffect: []alarmRef{a},
+			want:     []alarmRef{otherNs},
+		},
+		{
+			name:     "nothing in effect",
+			alarms:   []alarmRef{a},
+			inEffect: nil,
+			want:     []alarmRef{a},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingAlarms(tt.alarms, tt.inEffect); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("missingAlarms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlarmInState(t *testing.T) {
+	tests := []struct {
+		name   string
+		alarm  map[string]any
+		action string
+		want   bool
+	}{
+		{
+			name:   "acknowledged",
+			alarm:  map[string]any{"acknowledged": true, "suppressed": false},
+			action: "acknowledge",
+			want:   true,
+		},
+		{
+			name:   "not acknowledged",
+			alarm:  map[string]any{"acknowledged": false, "suppressed": true},
+			action: "acknowledge",
+			want:   false,
+		},
+		{
+			name:   "suppressed",
+			alarm:  map[string]any{"acknowledged": false, "suppressed": true},
+			action: "suppress",
+			want:   true,
+		},
+		{
+			name:   "acknowledged but not suppressed",
+			alarm:  map[string]any{"acknowledged": true},
+			action: "suppress",
+			want:   false,
+		},
+		{
+			name:   "cleared alarm without fields",
+			alarm:  map[string]any{},
+			action: "acknowledge",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := alarmInState(tt.alarm, tt.action); got != tt.want {
+				t.Errorf("alarmInState(%v, %q) = %v, want %v", tt.alarm, tt.action, got, tt.want)
+			}
+		})
+	}
+}
+
+// alarmTestAPI serves the alarms of a namespace, and records the alarms actions are applied to.
+type alarmTestAPI struct {
+	alarms  []map[string]any
+	applied []alarmRef
+}
+
+func (api *alarmTestAPI) handle(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			body, _ := json.Marshal(api.alarms)
+			writeJSON(w, http.StatusOK, string(body))
+		case http.MethodPut:
+			var alarms []alarmRef
+			if err := json.NewDecoder(req.Body).Decode(&alarms); err != nil {
+				t.Errorf("decoding the request body: %v", err)
+			}
+			api.applied = append(api.applied, alarms...)
+			writeJSON(w, http.StatusOK, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			writeJSON(w, http.StatusMethodNotAllowed, `{}`)
+		}
+	}
+}
+
+func TestAlarmAcknowledgementFilter(t *testing.T) {
+	a := alarmRef{Name: "InterfaceDown-leaf1-ethernet-1-1", Namespace: "eda"}
+	b := alarmRef{Name: "InterfaceDown-leaf1-ethernet-1-2", Namespace: "eda"}
+	alarm := func(ref alarmRef, acknowledged bool) map[string]any {
+		return map[string]any{"name": ref.Name, "namespace": ref.Namespace, "acknowledged": acknowledged, "suppressed": false}
+	}
+
+	tests := []struct {
+		name     string
+		single   bool
+		inEffect []alarmRef
+		// live are the alarms the API returns for the filter
+		live []map[string]any
+		// duration is the planned duration, the prior one is 60
+		duration    int64
+		wantUnknown bool
+		wantApplied []alarmRef
+		wantAlarms  []alarmRef
+	}{
+		{
+			name:        "filter without new alarms",
+			inEffect:    []alarmRef{a},
+			live:        []map[string]any{alarm(a, true)},
+			duration:    60,
+			wantAlarms:  []alarmRef{a},
+			wantApplied: nil,
+		},
+		{
+			name:        "filter with a new alarm",
+			inEffect:    []alarmRef{a},
+			live:        []map[string]any{alarm(a, true), alarm(b, false)},
+			duration:    60,
+			wantUnknown: true,
+			wantApplied: []alarmRef{b},
+			wantAlarms:  []alarmRef{a, b},
+		},
+		{
+			name:        "filter with a cleared alarm",
+			inEffect:    []alarmRef{a, b},
+			live:        []map[string]any{alarm(b, true)},
+			duration:    60,
+			wantApplied: nil,
+			wantAlarms:  []alarmRef{b},
+		},
+		{
+			name:        "filter with a new duration",
+			inEffect:    []alarmRef{a},
+			live:        []map[string]any{alarm(a, true)},
+			duration:    120,
+			wantApplied: []alarmRef{a},
+			wantAlarms:  []alarmRef{a},
+		},
+		{
+			name:        "single alarm",
+			single:      true,
+			inEffect:    []alarmRef{a},
+			duration:    60,
+			wantApplied: nil,
+			wantAlarms:  []alarmRef{a},
+		},
+		{
+			name:        "single alarm with a new duration",
+			single:      true,
+			inEffect:    []alarmRef{a},
+			duration:    120,
+			wantApplied: []alarmRef{a},
+			wantAlarms:  []alarmRef{a},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			api := &alarmTestAPI{alarms: tt.live}
+			r := &alarmAcknowledgementResource{providerData: newTestProviderData(t, api.handle(t))}
+
+			state := resource_alarm_acknowledgement.AlarmAcknowledgementModel{
+				Action:          types.StringValue("acknowledge"),
+				AlarmName:       types.StringNull(),
+				DeleteOnDestroy: types.BoolValue(false),
+				Duration:        types.Int64Value(60),
+				Filter:          types.StringValue(`type = "InterfaceDown"`),
+				Id:              types.StringValue(`eda/type = "InterfaceDown"`),
+				Namespace:       types.StringValue("eda"),
+			}
+			if tt.single {
+				state.AlarmName = types.StringValue(a.Name)
+				state.Filter = types.StringNull()
+				state.Id = types.StringValue("eda/" + a.Name)
+			}
+			if diags := setAlarmRefs(ctx, &state, tt.inEffect); diags.HasError() {
+				t.Fatalf("setAlarmRefs() diagnostics = %v", diags)
+			}
+			plan := state
+			plan.Duration = types.Int64Value(tt.duration)
+
+			// ModifyPlan plans the alarms again if an alarm matches the filter without the action
+			modifyResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(alarmTestState(t, &plan))}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan(alarmTestState(t, &plan)),
+				State: alarmTestState(t, &state),
+			}, modifyResp)
+			if modifyResp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", modifyResp.Diagnostics)
+			}
+			var planned resource_alarm_acknowledgement.AlarmAcknowledgementModel
+			modifyResp.Plan.Get(ctx, &planned)
+			if planned.Alarms.IsUnknown() != tt.wantUnknown {
+				t.Errorf("ModifyPlan() alarms = %v, want unknown %v", planned.Alarms, tt.wantUnknown)
+			}
+			if len(api.applied) != 0 {
+				t.Errorf("ModifyPlan() applied the action to %v", api.applied)
+			}
+
+			// Update applies the action to the alarms without it, or to all of them if the duration changed
+			updateResp := &resource.UpdateResponse{State: alarmTestState(t, nil)}
+			r.Update(ctx, resource.UpdateRequest{
+				Plan:  modifyResp.Plan,
+				State: alarmTestState(t, &state),
+			}, updateResp)
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("Update() diagnostics = %v", updateResp.Diagnostics)
+			}
+			if !reflect.DeepEqual(api.applied, tt.wantApplied) {
+				t.Errorf("Update() applied the action to %v, want %v", api.applied, tt.wantApplied)
+			}
+			var updated resource_alarm_acknowledgement.AlarmAcknowledgementModel
+			updateResp.State.Get(ctx, &updated)
+			alarms, _ := getAlarmRefs(ctx, &updated)
+			if !reflect.DeepEqual(alarms, tt.wantAlarms) {
+				t.Errorf("Update() alarms = %v, want %v", alarms, tt.wantAlarms)
+			}
+		})
+	}
+}
+
+// alarmTestState returns the state of data, or a null state if data is nil.
+func alarmTestState(t *testing.T, data *resource_alarm_acknowledgement.AlarmAcknowledgementModel) tfsdk.State {
+	ctx := context.Background()
+	s := resource_alarm_acknowledgement.AlarmAcknowledgementResourceSchema(ctx)
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if data != nil {
+		if diags := state.Set(ctx, data); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+	}
+	return state
+}
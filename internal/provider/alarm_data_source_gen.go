@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_alarm"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewAlarmDataSource() datasource.DataSource {
 }
 
 type alarmDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// alarmDataSourceModel is the model of the data source with the extra attribute.
+type alarmDataSourceModel struct {
+	datasource_alarm.AlarmModel
+	tfutils.ExtraModel
 }
 
 func (d *alarmDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *alarmDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 }
 
 func (d *alarmDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_alarm.AlarmDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_alarm.AlarmDataSourceSchema(ctx))
 }
 
 func (d *alarmDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_alarm.AlarmModel
+	var data alarmDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *alarmDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.AlarmModel, oasQueryParams(http.MethodGet, read_ds_alarm))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -79,7 +87,12 @@ func (d *alarmDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_alarm)
+	err = decoder.AnyMapToModel(ctx, result, &data.AlarmModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.AlarmModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -97,14 +110,14 @@ func (r *alarmDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
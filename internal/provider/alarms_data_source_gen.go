@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_alarms"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,7 @@ func NewAlarmsDataSource() datasource.DataSource {
 }
 
 type alarmsDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *alarmsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +35,11 @@ func (d *alarmsDataSource) Metadata(ctx context.Context, req datasource.Metadata
 }
 
 func (d *alarmsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_alarms.AlarmsDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddItemExtraAttribute(datasource_alarms.AlarmsCustomDataSourceSchema(ctx), "alarms")
 }
 
 func (d *alarmsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_alarms.AlarmsModel
+	var data datasource_alarms.AlarmsCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +49,7 @@ func (d *alarmsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.AlarmsModel, oasQueryParams(http.MethodGet, read_ds_alarms))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -77,14 +79,20 @@ func (d *alarmsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// Apply the client-side list options
+	items, err := data.Apply(ctx, oasConverter(http.MethodGet, read_ds_alarms, "alarms"), "alarms", result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error applying list options", err.Error())
+		return
+	}
+
 	newResult := map[string]any{
-		"alarms": result,
+		"alarms": items,
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, newResult, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	err = d.oasDecoder(http.MethodGet, read_ds_alarms, "alarms").AnyMapToModel(ctx, newResult, &data.AlarmsModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -100,14 +108,14 @@ func (r *alarmsDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
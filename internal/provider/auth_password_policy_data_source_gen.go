@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_auth_password_policy"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewAuthPasswordPolicyDataSource() datasource.DataSource {
 }
 
 type authPasswordPolicyDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// authPasswordPolicyDataSourceModel is the model of the data source with the extra attribute.
+type authPasswordPolicyDataSourceModel struct {
+	datasource_auth_password_policy.AuthPasswordPolicyModel
+	tfutils.ExtraModel
 }
 
 func (d *authPasswordPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *authPasswordPolicyDataSource) Metadata(ctx context.Context, req datasou
 }
 
 func (d *authPasswordPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_auth_password_policy.AuthPasswordPolicyDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_auth_password_policy.AuthPasswordPolicyDataSourceSchema(ctx))
 }
 
 func (d *authPasswordPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_auth_password_policy.AuthPasswordPolicyModel
+	var data authPasswordPolicyDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *authPasswordPolicyDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.AuthPasswordPolicyModel, oasQueryParams(http.MethodGet, read_ds_authPasswordPolicy))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,7 +84,12 @@ func (d *authPasswordPolicyDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_authPasswordPolicy)
+	err = decoder.AnyMapToModel(ctx, result, &data.AuthPasswordPolicyModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.AuthPasswordPolicyModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -94,14 +107,14 @@ func (r *authPasswordPolicyDataSource) Configure(_ context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
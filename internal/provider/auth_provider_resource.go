@@ -3,12 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_provider"
@@ -20,253 +20,164 @@ const (
 	read_rs_authProvider   = "/core/admin/federationproviders/{uuid}"
 	update_rs_authProvider = "/core/admin/federationproviders/{uuid}"
 	delete_rs_authProvider = "/core/admin/federationproviders/{uuid}"
+	test_rs_authProvider   = "/core/admin/federationprovidertest"
+
+	authProviderTestConnection     = "connection"
+	authProviderTestAuthentication = "authentication"
 )
 
+// authProviderFieldModes are the attributes of a federation provider that are not merged
+// from the API responses, the bind credential is never returned.
+var authProviderFieldModes = tfutils.FieldModes{
+	"auth.bind_credential": tfutils.FieldWriteOnly,
+}
+
 var (
 	_ resource.Resource                = (*authProviderResource)(nil)
 	_ resource.ResourceWithConfigure   = (*authProviderResource)(nil)
 	_ resource.ResourceWithImportState = (*authProviderResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*authProviderResource)(nil)
 )
 
 func NewAuthProviderResource() resource.Resource {
-	return &authProviderResource{}
+	return &authProviderResource{&crudResource[resource_auth_provider.AuthProviderCustomModel]{
+		typeName: "_auth_provider",
+		schema:   resource_auth_provider.AuthProviderCustomResourceSchema,
+		paths: crudPaths{
+			Create: create_rs_authProvider,
+			Read:   read_rs_authProvider,
+			Update: update_rs_authProvider,
+			Delete: delete_rs_authProvider,
+		},
+		identity: func(data *resource_auth_provider.AuthProviderCustomModel) map[string]string {
+			return map[string]string{
+				"uuid": tfutils.StringValue(data.Uuid),
+			}
+		},
+		fieldModes: authProviderFieldModes,
+		keepState: func(data, state *resource_auth_provider.AuthProviderCustomModel) {
+			data.Uuid = state.Uuid
+		},
+		beforeCreate:  dropSkipConnectionTest,
+		beforeUpdate:  dropSkipConnectionTest,
+		afterMerge:    defaultSkipConnectionTest,
+		parseImportID: splitImportID("uuid"),
+	}}
 }
 
+// authProviderResource is the crudResource of federation providers, which tests their LDAP
+// server when they are planned.
 type authProviderResource struct {
-	client *apiclient.EdaApiClient
+	*crudResource[resource_auth_provider.AuthProviderCustomModel]
 }
 
-func (r *authProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_auth_provider"
+// dropSkipConnectionTest removes skip_connection_test from the request body, as it only
+// configures the plan of the provider and is not part of the API.
+func dropSkipConnectionTest(_ context.Context, _ *resource_auth_provider.AuthProviderCustomModel, body map[string]any) error {
+	delete(body, "skipConnectionTest")
+	return nil
 }
 
-func (r *authProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_auth_provider.AuthProviderResourceSchema(ctx)
-}
-
-func (r *authProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_auth_provider.AuthProviderModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Initialize unknown values with null defaults
-	err := tfutils.FillMissingValues(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error filling missing values", err.Error())
-		return
+// defaultSkipConnectionTest sets skip_connection_test to its schema default when it is null,
+// as imported resources have no prior value.
+func defaultSkipConnectionTest(_ context.Context, data *resource_auth_provider.AuthProviderCustomModel) {
+	if data.SkipConnectionTest.IsNull() {
+		data.SkipConnectionTest = types.BoolValue(false)
 	}
+}
 
-	// Convert Terraform model to API request body
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
+// ModifyPlan tests the connection to, and the bind against, the LDAP server before the
+// provider is created or updated, so that a wrong URL or bind DN fails the plan.
+func (r *authProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to test on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
 
-	// Create API call logic
-	tflog.Info(ctx, "Create()::API request", map[string]any{
-		"path": create_rs_authProvider,
-		"body": spew.Sdump(reqBody),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err = r.client.Create(ctx, create_rs_authProvider, nil, reqBody, &result)
-
-	tflog.Info(ctx, "Create()::API returned", map[string]any{
-		"path":      create_rs_authProvider,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
+	var plan, state resource_auth_provider.AuthProviderCustomModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating resource", err.Error())
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	if plan.SkipConnectionTest.ValueBool() || plan.Url.IsUnknown() {
 		return
 	}
 
-	// Save created data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
-}
-
-func (r *authProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_auth_provider.AuthProviderModel
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	// Only re-test an existing provider when its connection settings change
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Url.Equal(state.Url) && plan.Tls.Equal(state.Tls) &&
+			plan.Timeout.Equal(state.Timeout) && plan.Auth.Equal(state.Auth) {
+			return
+		}
 	}
 
-	// Read API call logic
-	tflog.Info(ctx, "Read()::API request", map[string]any{
-		"path": read_rs_authProvider,
-		"data": spew.Sdump(data),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err := r.client.Get(ctx, read_rs_authProvider, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, &result)
-
-	tflog.Info(ctx, "Read()::API returned", map[string]any{
-		"path":      read_rs_authProvider,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading resource", err.Error())
-		return
+	params := map[string]any{
+		"action": authProviderTestConnection,
+		"url":    plan.Url.ValueString(),
 	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
+	if !plan.Tls.IsNull() && !plan.Tls.IsUnknown() {
+		params["tls"] = plan.Tls.ValueBool()
 	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *authProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, state resource_auth_provider.AuthProviderModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	if !plan.Timeout.IsNull() && !plan.Timeout.IsUnknown() {
+		params["timeout"] = plan.Timeout.ValueInt64()
 	}
 
-	data.Uuid = state.Uuid
-
-	err := tfutils.FillMissingValues(ctx, &data)
+	err := testAuthProvider(ctx, r.client, params)
 	if err != nil {
-		resp.Diagnostics.AddError("Error filling missing values", err.Error())
+		resp.Diagnostics.AddAttributeError(path.Root("url"), "LDAP connection test failed",
+			fmt.Sprintf("Unable to connect to %s: %s\n\n"+
+				"Set skip_connection_test = true to create or update the provider without this test.",
+				plan.Url.ValueString(), err.Error()))
 		return
 	}
 
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
+	// Without bind credentials the provider connects anonymously, so there is nothing more to
+	// test. Credentials that are not known until apply are not tested either.
+	if plan.Auth.IsNull() || plan.Auth.IsUnknown() ||
+		plan.Auth.BindDn.IsNull() || plan.Auth.BindDn.IsUnknown() ||
+		plan.Auth.BindCredential.IsNull() || plan.Auth.BindCredential.IsUnknown() {
 		return
 	}
 
-	// Update API call logic
-	tflog.Info(ctx, "Update()::API request", map[string]any{
-		"path": update_rs_authProvider,
-		"body": spew.Sdump(reqBody),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err = r.client.Update(ctx, update_rs_authProvider, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, reqBody, &result)
-
-	tflog.Info(ctx, "Update()::API returned", map[string]any{
-		"path":      update_rs_authProvider,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating resource", err.Error())
-		return
+	params["action"] = authProviderTestAuthentication
+	params["auth"] = map[string]any{
+		"bindDN":         plan.Auth.BindDn.ValueString(),
+		"bindCredential": plan.Auth.BindCredential.ValueString(),
 	}
 
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	err = testAuthProvider(ctx, r.client, params)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
+		resp.Diagnostics.AddAttributeError(path.Root("auth").AtName("bind_dn"), "LDAP bind test failed",
+			fmt.Sprintf("Unable to bind to %s as %s: %s\n\n"+
+				"Set skip_connection_test = true to create or update the provider without this test.",
+				plan.Url.ValueString(), plan.Auth.BindDn.ValueString(), err.Error()))
 	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *authProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_auth_provider.AuthProviderModel
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Delete API call logic
-	tflog.Info(ctx, "Delete()::API request", map[string]any{
-		"path": delete_rs_authProvider,
-		"data": spew.Sdump(data),
+// testAuthProvider asks EDA to test a federation provider with the given test parameters.
+func testAuthProvider(ctx context.Context, client *apiclient.EdaApiClient, params map[string]any) error {
+	tflog.Info(ctx, "testAuthProvider()::API request", map[string]any{
+		"path":   test_rs_authProvider,
+		"action": params["action"],
+		"url":    params["url"],
 	})
 
 	t0 := time.Now()
 	result := map[string]any{}
 
-	err := r.client.Delete(ctx, delete_rs_authProvider, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, &result)
+	err := client.Create(ctx, test_rs_authProvider, nil, params, &result)
 
-	tflog.Info(ctx, "Delete()::API returned", map[string]any{
-		"path":      delete_rs_authProvider,
+	tflog.Info(ctx, "testAuthProvider()::API returned", map[string]any{
+		"path":      test_rs_authProvider,
 		"result":    spew.Sdump(result),
 		"timeTaken": time.Since(t0).String(),
 	})
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting resource", err.Error())
-		return
-	}
-}
-
-// Configure adds the provider configured client to the resource.
-func (r *authProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Add a nil check when handling ProviderData because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-	r.client = client
-}
-
-// ImportState implements resource.ResourceWithImportState.
-func (r *authProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) < 1 {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected format: id = <uuid>, got: id = %s", req.ID))
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), parts[0])...)
+	return err
 }
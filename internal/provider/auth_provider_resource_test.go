@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_provider"
+)
+
+func TestAuthProviderModifyPlan(t *testing.T) {
+	auth := func(bindDn, bindCredential types.String) resource_auth_provider.AuthValue {
+		return resource_auth_provider.NewAuthValueMust(resource_auth_provider.AuthValue{}.AttributeTypes(context.Background()), map[string]attr.Value{
+			"bind_dn":         bindDn,
+			"bind_credential": bindCredential,
+		})
+	}
+	bindAuth := auth(types.StringValue("cn=admin,dc=example,dc=org"), types.StringValue("secret"))
+	prior := &resource_auth_provider.AuthProviderCustomModel{
+		Auth:               bindAuth,
+		Name:               types.StringValue("ldap"),
+		SkipConnectionTest: types.BoolValue(false),
+		Timeout:            types.Int64Value(10),
+		Tls:                types.BoolValue(false),
+		Url:                types.StringValue("ldap://ldap.example.org:389"),
+		Uuid:               types.StringValue("7f0c0a52-5b3e-4f5c-9b9e-0d4c1f1e6a10"),
+	}
+
+	tests := []struct {
+		name string
+		// edit changes the plan, a copy of prior
+		edit  func(plan *resource_auth_provider.AuthProviderCustomModel)
+		state *resource_auth_provider.AuthProviderCustomModel
+		// failConnection makes the connection test fail
+		failConnection bool
+		wantActions    []string
+		wantErr        bool
+	}{
+		{
+			name:        "create",
+			edit:        func(plan *resource_auth_provider.AuthProviderCustomModel) {},
+			wantActions: []string{"connection", "authentication"},
+		},
+		{
+			name: "skip_connection_test",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.SkipConnectionTest = types.BoolValue(true)
+			},
+			wantActions: nil,
+		},
+		{
+			name: "unknown url",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Url = types.StringUnknown()
+			},
+			wantActions: nil,
+		},
+		{
+			name: "anonymous bind",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Auth = resource_auth_provider.NewAuthValueNull()
+			},
+			wantActions: []string{"connection"},
+		},
+		{
+			name: "null bind_credential",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Auth = auth(types.StringValue("cn=admin,dc=example,dc=org"), types.StringNull())
+			},
+			wantActions: []string{"connection"},
+		},
+		{
+			name: "unknown bind_credential",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Auth = auth(types.StringValue("cn=admin,dc=example,dc=org"), types.StringUnknown())
+			},
+			wantActions: []string{"connection"},
+		},
+		{
+			name: "unknown auth",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Auth = resource_auth_provider.NewAuthValueUnknown()
+			},
+			wantActions: []string{"connection"},
+		},
+		{
+			name:        "unchanged connection settings",
+			edit:        func(plan *resource_auth_provider.AuthProviderCustomModel) {},
+			state:       prior,
+			wantActions: nil,
+		},
+		{
+			name: "changed url",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Url = types.StringValue("ldaps://ldap.example.org:636")
+			},
+			state:       prior,
+			wantActions: []string{"connection", "authentication"},
+		},
+		{
+			name: "changed name only",
+			edit: func(plan *resource_auth_provider.AuthProviderCustomModel) {
+				plan.Name = types.StringValue("corporate-ldap")
+			},
+			state:       prior,
+			wantActions: nil,
+		},
+		{
+			name:           "failed connection",
+			edit:           func(plan *resource_auth_provider.AuthProviderCustomModel) {},
+			failConnection: true,
+			wantActions:    []string{"connection"},
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			var actions []string
+			r := NewAuthProviderResource().(*authProviderResource)
+			r.providerData = newTestProviderData(t, func(w http.ResponseWriter, req *http.Request) {
+				var params map[string]any
+				if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+					t.Errorf("decoding the request body: %v", err)
+				}
+				action, _ := params["action"].(string)
+				actions = append(actions, action)
+				if tt.failConnection {
+					writeJSON(w, http.StatusBadRequest, `{"code": 400, "message": "connection refused"}`)
+					return
+				}
+				writeJSON(w, http.StatusOK, `{}`)
+			})
+
+			plan := *prior
+			plan.Uuid = types.StringUnknown()
+			tt.edit(&plan)
+			state := authProviderTestState(t, tt.state)
+			if tt.state != nil {
+				plan.Uuid = tt.state.Uuid
+			}
+
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(authProviderTestState(t, &plan))}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan(authProviderTestState(t, &plan)),
+				State: state,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ModifyPlan() diagnostics = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+			if !reflect.DeepEqual(actions, tt.wantActions) {
+				t.Errorf("ModifyPlan() tested %q, want %q", actions, tt.wantActions)
+			}
+		})
+	}
+}
+
+// authProviderTestState returns the state of data, or a null state if data is nil.
+func authProviderTestState(t *testing.T, data *resource_auth_provider.AuthProviderCustomModel) tfsdk.State {
+	ctx := context.Background()
+	s := resource_auth_provider.AuthProviderCustomResourceSchema(ctx)
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if data != nil {
+		if diags := state.Set(ctx, data); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+	}
+	return state
+}
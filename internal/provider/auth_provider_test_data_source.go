@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_auth_provider_check"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+var (
+	_ datasource.DataSource              = (*authProviderTestDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*authProviderTestDataSource)(nil)
+)
+
+func NewAuthProviderTestDataSource() datasource.DataSource {
+	return &authProviderTestDataSource{}
+}
+
+type authProviderTestDataSource struct {
+	providerData
+}
+
+func (d *authProviderTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_provider_test"
+}
+
+func (d *authProviderTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_auth_provider_check.AuthProviderTestDataSourceSchema(ctx)
+}
+
+func (d *authProviderTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_auth_provider_check.AuthProviderTestModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API request body
+	reqBody, err := oasConverter(http.MethodPost, test_rs_authProvider).ModelToAnyMap(ctx, &data)
+	if err != nil {
+		tfutils.AddConversionError(&resp.Diagnostics, "Error building request", err)
+		return
+	}
+
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": test_rs_authProvider,
+		"data": spew.Sdump(data),
+	})
+
+	// A failed test is reported in the result rather than as an error,
+	// so that it can be checked with preconditions and check blocks.
+	err = testAuthProvider(ctx, d.client, reqBody)
+	if err != nil {
+		data.Success = types.BoolValue(false)
+		data.Message = types.StringValue(err.Error())
+	} else {
+		data.Success = types.BoolValue(true)
+		data.Message = types.StringValue("")
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *authProviderTestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
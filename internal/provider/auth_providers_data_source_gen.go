@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_auth_providers"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,7 @@ func NewAuthProvidersDataSource() datasource.DataSource {
 }
 
 type authProvidersDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *authProvidersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,7 +35,7 @@ func (d *authProvidersDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *authProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_auth_providers.AuthProvidersDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddItemExtraAttribute(datasource_auth_providers.AuthProvidersDataSourceSchema(ctx), "auth_providers")
 }
 
 func (d *authProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -47,7 +49,7 @@ func (d *authProvidersDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, read_ds_authProviders))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -80,9 +82,8 @@ func (d *authProvidersDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, newResult, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	err = d.oasDecoder(http.MethodGet, read_ds_authProviders, "authProviders").AnyMapToModel(ctx, newResult, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -98,14 +99,14 @@ func (r *authProvidersDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
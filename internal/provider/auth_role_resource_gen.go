@@ -0,0 +1,37 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_role"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	create_rs_authRole = "/core/admin/namespaces/{namespace}/roles"
+	read_rs_authRole   = "/core/admin/namespaces/{namespace}/roles/{name}"
+	update_rs_authRole = "/core/admin/namespaces/{namespace}/roles/{name}"
+	delete_rs_authRole = "/core/admin/namespaces/{namespace}/roles/{name}"
+)
+
+func NewAuthRoleResource() resource.Resource {
+	return &crudResource[resource_auth_role.AuthRoleModel]{
+		typeName: "_auth_role",
+		schema:   resource_auth_role.AuthRoleResourceSchema,
+		paths: crudPaths{
+			Create: create_rs_authRole,
+			Read:   read_rs_authRole,
+			Update: update_rs_authRole,
+			Delete: delete_rs_authRole,
+		},
+		identity: func(data *resource_auth_role.AuthRoleModel) map[string]string {
+			return map[string]string{
+				"namespace": tfutils.StringValue(data.Namespace),
+				"name":      tfutils.StringValue(data.Name),
+			}
+		},
+		reread:        true,
+		parseImportID: splitImportID("namespace", "name"),
+	}
+}
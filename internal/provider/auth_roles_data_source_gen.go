@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_auth_roles"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,7 @@ func NewAuthRolesDataSource() datasource.DataSource {
 }
 
 type authRolesDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *authRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,7 +35,7 @@ func (d *authRolesDataSource) Metadata(ctx context.Context, req datasource.Metad
 }
 
 func (d *authRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_auth_roles.AuthRolesDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddItemExtraAttribute(datasource_auth_roles.AuthRolesDataSourceSchema(ctx), "auth_roles")
 }
 
 func (d *authRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -47,7 +49,7 @@ func (d *authRolesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, read_ds_authRoles))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -82,9 +84,8 @@ func (d *authRolesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, newResult, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	err = d.oasDecoder(http.MethodGet, read_ds_authRoles, "authRoles").AnyMapToModel(ctx, newResult, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -100,14 +101,14 @@ func (r *authRolesDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
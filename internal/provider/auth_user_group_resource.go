@@ -1,272 +0,0 @@
-package provider
-
-import (
-	"context"
-	"fmt"
-	"strings"
-	"time"
-
-	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_user_group"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
-)
-
-const (
-	create_rs_authUserGroup = "/core/admin/groups"
-	read_rs_authUserGroup   = "/core/admin/groups/{uuid}"
-	update_rs_authUserGroup = "/core/admin/groups/{uuid}"
-	delete_rs_authUserGroup = "/core/admin/groups/{uuid}"
-)
-
-var (
-	_ resource.Resource                = (*authUserGroupResource)(nil)
-	_ resource.ResourceWithConfigure   = (*authUserGroupResource)(nil)
-	_ resource.ResourceWithImportState = (*authUserGroupResource)(nil)
-)
-
-func NewAuthUserGroupResource() resource.Resource {
-	return &authUserGroupResource{}
-}
-
-type authUserGroupResource struct {
-	client *apiclient.EdaApiClient
-}
-
-func (r *authUserGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_auth_user_group"
-}
-
-func (r *authUserGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_auth_user_group.AuthUserGroupCustomResourceSchema(ctx)
-}
-
-func (r *authUserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_auth_user_group.AuthUserGroupCustomModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Initialize unknown values with null defaults
-	err := tfutils.FillMissingValues(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error filling missing values", err.Error())
-		return
-	}
-
-	// Convert Terraform model to API request body
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
-		return
-	}
-
-	// Create API call logic
-	tflog.Info(ctx, "Create()::API request", map[string]any{
-		"path": create_rs_authUserGroup,
-		"body": spew.Sdump(reqBody),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err = r.client.Create(ctx, create_rs_authUserGroup, nil, reqBody, &result)
-
-	tflog.Info(ctx, "Create()::API returned", map[string]any{
-		"path":      create_rs_authUserGroup,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating resource", err.Error())
-		return
-	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
-	}
-
-	// Save created data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
-}
-
-func (r *authUserGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_auth_user_group.AuthUserGroupCustomModel
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Read API call logic
-	tflog.Info(ctx, "Read()::API request", map[string]any{
-		"path": read_rs_authUserGroup,
-		"data": spew.Sdump(data),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err := r.client.Get(ctx, read_rs_authUserGroup, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, &result)
-
-	tflog.Info(ctx, "Read()::API returned", map[string]any{
-		"path":      read_rs_authUserGroup,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading resource", err.Error())
-		return
-	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
-	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *authUserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, state resource_auth_user_group.AuthUserGroupCustomModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	data.Uuid = state.Uuid
-
-	err := tfutils.FillMissingValues(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error filling missing values", err.Error())
-		return
-	}
-
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
-		return
-	}
-
-	// Update API call logic
-	tflog.Info(ctx, "Update()::API request", map[string]any{
-		"path": update_rs_authUserGroup,
-		"body": spew.Sdump(reqBody),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err = r.client.Update(ctx, update_rs_authUserGroup, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, reqBody, &result)
-
-	tflog.Info(ctx, "Update()::API returned", map[string]any{
-		"path":      update_rs_authUserGroup,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating resource", err.Error())
-		return
-	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
-	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *authUserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_auth_user_group.AuthUserGroupCustomModel
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Delete API call logic
-	tflog.Info(ctx, "Delete()::API request", map[string]any{
-		"path": delete_rs_authUserGroup,
-		"data": spew.Sdump(data),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err := r.client.Delete(ctx, delete_rs_authUserGroup, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, &result)
-
-	tflog.Info(ctx, "Delete()::API returned", map[string]any{
-		"path":      delete_rs_authUserGroup,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting resource", err.Error())
-		return
-	}
-}
-
-// Configure adds the provider configured client to the resource.
-func (r *authUserGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Add a nil check when handling ProviderData because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-	r.client = client
-}
-
-// ImportState implements resource.ResourceWithImportState.
-func (r *authUserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) < 1 {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected id = <uuid> format, got: id = %s", req.ID))
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), parts[0])...)
-}
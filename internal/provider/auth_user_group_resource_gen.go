@@ -0,0 +1,38 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_user_group"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	create_rs_authUserGroup = "/core/admin/groups"
+	read_rs_authUserGroup   = "/core/admin/groups/{uuid}"
+	update_rs_authUserGroup = "/core/admin/groups/{uuid}"
+	delete_rs_authUserGroup = "/core/admin/groups/{uuid}"
+)
+
+func NewAuthUserGroupResource() resource.Resource {
+	return &crudResource[resource_auth_user_group.AuthUserGroupCustomModel]{
+		typeName: "_auth_user_group",
+		schema:   resource_auth_user_group.AuthUserGroupCustomResourceSchema,
+		paths: crudPaths{
+			Create: create_rs_authUserGroup,
+			Read:   read_rs_authUserGroup,
+			Update: update_rs_authUserGroup,
+			Delete: delete_rs_authUserGroup,
+		},
+		identity: func(data *resource_auth_user_group.AuthUserGroupCustomModel) map[string]string {
+			return map[string]string{
+				"uuid": tfutils.StringValue(data.Uuid),
+			}
+		},
+		keepState: func(data, state *resource_auth_user_group.AuthUserGroupCustomModel) {
+			data.Uuid = state.Uuid
+		},
+		parseImportID: splitImportID("uuid"),
+	}
+}
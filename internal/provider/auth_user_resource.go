@@ -1,272 +1,12 @@
 package provider
 
 import (
-	"context"
-	"fmt"
-	"strings"
-	"time"
-
-	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_user"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
-const (
-	create_rs_authUser = "/core/admin/users"
-	read_rs_authUser   = "/core/admin/users/{uuid}"
-	update_rs_authUser = "/core/admin/users/{uuid}"
-	delete_rs_authUser = "/core/admin/users/{uuid}"
-)
-
-var (
-	_ resource.Resource                = (*authUserResource)(nil)
-	_ resource.ResourceWithConfigure   = (*authUserResource)(nil)
-	_ resource.ResourceWithImportState = (*authUserResource)(nil)
-)
-
-func NewAuthUserResource() resource.Resource {
-	return &authUserResource{}
-}
-
-type authUserResource struct {
-	client *apiclient.EdaApiClient
-}
-
-func (r *authUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_auth_user"
-}
-
-func (r *authUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_auth_user.AuthUserResourceSchema(ctx)
-}
-
-func (r *authUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_auth_user.AuthUserModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Initialize unknown values with null defaults
-	err := tfutils.FillMissingValues(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error filling missing values", err.Error())
-		return
-	}
-
-	// Convert Terraform model to API request body
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
-		return
-	}
-
-	// Create API call logic
-	tflog.Info(ctx, "Create()::API request", map[string]any{
-		"path": create_rs_authUser,
-		"body": spew.Sdump(reqBody),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err = r.client.Create(ctx, create_rs_authUser, nil, reqBody, &result)
-
-	tflog.Info(ctx, "Create()::API returned", map[string]any{
-		"path":      create_rs_authUser,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating resource", err.Error())
-		return
-	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
-	}
-
-	// Save created data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
-}
-
-func (r *authUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_auth_user.AuthUserModel
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Read API call logic
-	tflog.Info(ctx, "Read()::API request", map[string]any{
-		"path": read_rs_authUser,
-		"data": spew.Sdump(data),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err := r.client.Get(ctx, read_rs_authUser, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, &result)
-
-	tflog.Info(ctx, "Read()::API returned", map[string]any{
-		"path":      read_rs_authUser,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading resource", err.Error())
-		return
-	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
-	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *authUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, state resource_auth_user.AuthUserModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	data.Uuid = state.Uuid
-
-	err := tfutils.FillMissingValues(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error filling missing values", err.Error())
-		return
-	}
-
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
-		return
-	}
-
-	// Update API call logic
-	tflog.Info(ctx, "Update()::API request", map[string]any{
-		"path": update_rs_authUser,
-		"body": spew.Sdump(reqBody),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err = r.client.Update(ctx, update_rs_authUser, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, reqBody, &result)
-
-	tflog.Info(ctx, "Update()::API returned", map[string]any{
-		"path":      update_rs_authUser,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating resource", err.Error())
-		return
-	}
-
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
-	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *authUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_auth_user.AuthUserModel
-
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Delete API call logic
-	tflog.Info(ctx, "Delete()::API request", map[string]any{
-		"path": delete_rs_authUser,
-		"data": spew.Sdump(data),
-	})
-
-	t0 := time.Now()
-	result := map[string]any{}
-
-	err := r.client.Delete(ctx, delete_rs_authUser, map[string]string{
-		"uuid": tfutils.StringValue(data.Uuid),
-	}, &result)
-
-	tflog.Info(ctx, "Delete()::API returned", map[string]any{
-		"path":      delete_rs_authUser,
-		"result":    spew.Sdump(result),
-		"timeTaken": time.Since(t0).String(),
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting resource", err.Error())
-		return
-	}
-}
-
-// Configure adds the provider configured client to the resource.
-func (r *authUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Add a nil check when handling ProviderData because Terraform
-	// sets that data after it calls the ConfigureProvider RPC.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-	r.client = client
-}
-
-// ImportState implements resource.ResourceWithImportState.
-func (r *authUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) < 1 {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected format: id = <uuid>, got: id = %s", req.ID))
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), parts[0])...)
+// authUserFieldModes are the attributes of a user that are not merged from the API responses,
+// the password is never returned and the status is only set by EDA.
+var authUserFieldModes = tfutils.FieldModes{
+	"password": tfutils.FieldWriteOnly,
+	"status":   tfutils.FieldServerOwned,
 }
@@ -0,0 +1,39 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_auth_user"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	create_rs_authUser = "/core/admin/users"
+	read_rs_authUser   = "/core/admin/users/{uuid}"
+	update_rs_authUser = "/core/admin/users/{uuid}"
+	delete_rs_authUser = "/core/admin/users/{uuid}"
+)
+
+func NewAuthUserResource() resource.Resource {
+	return &crudResource[resource_auth_user.AuthUserModel]{
+		typeName: "_auth_user",
+		schema:   resource_auth_user.AuthUserResourceSchema,
+		paths: crudPaths{
+			Create: create_rs_authUser,
+			Read:   read_rs_authUser,
+			Update: update_rs_authUser,
+			Delete: delete_rs_authUser,
+		},
+		identity: func(data *resource_auth_user.AuthUserModel) map[string]string {
+			return map[string]string{
+				"uuid": tfutils.StringValue(data.Uuid),
+			}
+		},
+		fieldModes: authUserFieldModes,
+		keepState: func(data, state *resource_auth_user.AuthUserModel) {
+			data.Uuid = state.Uuid
+		},
+		parseImportID: splitImportID("uuid"),
+	}
+}
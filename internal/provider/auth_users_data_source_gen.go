@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_auth_users"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,7 @@ func NewAuthUsersDataSource() datasource.DataSource {
 }
 
 type authUsersDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *authUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +35,11 @@ func (d *authUsersDataSource) Metadata(ctx context.Context, req datasource.Metad
 }
 
 func (d *authUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_auth_users.AuthUsersDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddItemExtraAttribute(datasource_auth_users.AuthUsersCustomDataSourceSchema(ctx), "auth_users")
 }
 
 func (d *authUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_auth_users.AuthUsersModel
+	var data datasource_auth_users.AuthUsersCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +49,7 @@ func (d *authUsersDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.AuthUsersModel, oasQueryParams(http.MethodGet, read_ds_authUsers))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -75,14 +77,20 @@ func (d *authUsersDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	// Apply the client-side list options
+	items, err := data.Apply(ctx, oasConverter(http.MethodGet, read_ds_authUsers, "authUsers"), "authUsers", result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error applying list options", err.Error())
+		return
+	}
+
 	newResult := map[string]any{
-		"authUsers": result,
+		"authUsers": items,
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, newResult, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	err = d.oasDecoder(http.MethodGet, read_ds_authUsers, "authUsers").AnyMapToModel(ctx, newResult, &data.AuthUsersModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -98,14 +106,14 @@ func (r *authUsersDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_branch_diff_summary"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	read_ds_branchDiffSummary       = "/core/branches/v1/diffsummary"
+	read_ds_branchDiffSummaryByHash = "/core/branches/v1/diffsummary/{hash}"
+)
+
+var (
+	_ datasource.DataSource              = (*branchDiffSummaryDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*branchDiffSummaryDataSource)(nil)
+)
+
+func NewBranchDiffSummaryDataSource() datasource.DataSource {
+	return &branchDiffSummaryDataSource{}
+}
+
+type branchDiffSummaryDataSource struct {
+	providerData
+}
+
+func (d *branchDiffSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_diff_summary"
+}
+
+func (d *branchDiffSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_branch_diff_summary.BranchDiffSummaryDataSourceSchema(ctx)
+}
+
+func (d *branchDiffSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_branch_diff_summary.BranchDiffSummaryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The summary can be pinned to a branch head, which is then a path param
+	readPath := read_ds_branchDiffSummary
+	pathParams := map[string]string{}
+	if !data.Hash.IsNull() {
+		readPath = read_ds_branchDiffSummaryByHash
+		pathParams["hash"] = data.Hash.ValueString()
+	}
+
+	// Extract query params from Terraform model
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, readPath))
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting query params", err.Error())
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path":  readPath,
+		"data":  spew.Sdump(data),
+		"query": queryParams,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+	err = d.client.GetByQuery(ctx, readPath, pathParams, queryParams, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      readPath,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model
+	err = d.oasDecoder(http.MethodGet, readPath).AnyMapToModel(ctx, result, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *branchDiffSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
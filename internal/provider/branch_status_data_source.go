@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_branch_status"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const read_ds_branchStatus = "/core/branches/v1/status"
+
+var (
+	_ datasource.DataSource              = (*branchStatusDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*branchStatusDataSource)(nil)
+)
+
+func NewBranchStatusDataSource() datasource.DataSource {
+	return &branchStatusDataSource{}
+}
+
+type branchStatusDataSource struct {
+	providerData
+}
+
+func (d *branchStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_status"
+}
+
+func (d *branchStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_branch_status.BranchStatusDataSourceSchema(ctx)
+}
+
+func (d *branchStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_branch_status.BranchStatusModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Extract query params from Terraform model
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, read_ds_branchStatus))
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting query params", err.Error())
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path":  read_ds_branchStatus,
+		"data":  spew.Sdump(data),
+		"query": queryParams,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+	err = d.client.GetByQuery(ctx, read_ds_branchStatus, nil, queryParams, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      read_ds_branchStatus,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model
+	err = d.oasDecoder(http.MethodGet, read_ds_branchStatus).AnyMapToModel(ctx, result, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *branchStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
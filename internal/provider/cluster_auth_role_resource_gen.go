@@ -0,0 +1,36 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_cluster_auth_role"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	create_rs_clusterAuthRole = "/core/admin/roles"
+	read_rs_clusterAuthRole   = "/core/admin/roles/{name}"
+	update_rs_clusterAuthRole = "/core/admin/roles/{name}"
+	delete_rs_clusterAuthRole = "/core/admin/roles/{name}"
+)
+
+func NewClusterAuthRoleResource() resource.Resource {
+	return &crudResource[resource_cluster_auth_role.ClusterAuthRoleModel]{
+		typeName: "_cluster_auth_role",
+		schema:   resource_cluster_auth_role.ClusterAuthRoleResourceSchema,
+		paths: crudPaths{
+			Create: create_rs_clusterAuthRole,
+			Read:   read_rs_clusterAuthRole,
+			Update: update_rs_clusterAuthRole,
+			Delete: delete_rs_clusterAuthRole,
+		},
+		identity: func(data *resource_cluster_auth_role.ClusterAuthRoleModel) map[string]string {
+			return map[string]string{
+				"name": tfutils.StringValue(data.Name),
+			}
+		},
+		reread:        true,
+		parseImportID: splitImportID("name"),
+	}
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+)
+
+// crBatcher collects the CR operations of concurrently applied resources into a
+// single transaction. The first operation opens a batch, which is posted once the
+// window has passed or the batch is full, and every operation gets the outcome.
+// Terraform applies at most -parallelism resources at once, which also bounds a batch.
+type crBatcher struct {
+	client  *apiclient.EdaApiClient
+	maxSize int
+	window  time.Duration
+	// run posts a transaction, runCrTransaction with the client unless replaced in tests.
+	run func(ctx context.Context, description string, crs []any) (int64, error)
+
+	mu      sync.Mutex
+	pending []*crBatchOp
+	timer   *time.Timer
+}
+
+type crBatchOp struct {
+	op     string
+	target crTarget
+	cr     any
+	done   chan crBatchResult
+}
+
+type crBatchResult struct {
+	id  int64
+	err error
+}
+
+func newCrBatcher(client *apiclient.EdaApiClient, maxSize int, window time.Duration) *crBatcher {
+	return &crBatcher{
+		client:  client,
+		maxSize: maxSize,
+		window:  window,
+		run: func(ctx context.Context, description string, crs []any) (int64, error) {
+			return runCrTransaction(ctx, client, description, crs)
+		},
+	}
+}
+
+// Submit adds a CR operation to the current batch and waits for the batch to complete.
+// It returns the id of the transaction, and an error if the transaction failed. If ctx is
+// done before the batch is posted, the operation is withdrawn from it and ctx.Err() returned.
+func (b *crBatcher) Submit(ctx context.Context, op string, target crTarget, cr any) (int64, error) {
+	if b.maxSize <= 1 || b.window <= 0 {
+		return b.run(ctx, crTransactionDescription(op, target), []any{cr})
+	}
+
+	batchOp := &crBatchOp{
+		op:     op,
+		target: target,
+		cr:     cr,
+		done:   make(chan crBatchResult, 1),
+	}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, batchOp)
+	switch {
+	case len(b.pending) >= b.maxSize:
+		batch := b.takeLocked()
+		b.mu.Unlock()
+		go b.flush(batch)
+	case len(b.pending) == 1:
+		var timer *time.Timer
+		timer = time.AfterFunc(b.window, func() {
+			b.mu.Lock()
+			// The batch of this timer was already taken, by size or by cancellation
+			if b.timer != timer {
+				b.mu.Unlock()
+				return
+			}
+			batch := b.takeLocked()
+			b.mu.Unlock()
+			b.flush(batch)
+		})
+		b.timer = timer
+		b.mu.Unlock()
+	default:
+		b.mu.Unlock()
+	}
+
+	tflog.Info(ctx, "Submit()::Waiting for batched transaction", map[string]any{
+		"op":     op,
+		"target": target.ID(),
+	})
+
+	select {
+	case res := <-batchOp.done:
+		return res.id, res.err
+	case <-ctx.Done():
+	}
+
+	// An operation that is still pending is withdrawn, so that it is not applied once
+	// Terraform has recorded the failure. One that is being posted can no longer be,
+	// and its outcome is what the CR ends up as.
+	b.mu.Lock()
+	withdrawn := b.withdrawLocked(batchOp)
+	b.mu.Unlock()
+	if withdrawn {
+		return 0, ctx.Err()
+	}
+	res := <-batchOp.done
+	return res.id, res.err
+}
+
+// withdrawLocked removes batchOp from the pending operations, and reports whether it was
+// pending. b.mu must be held.
+func (b *crBatcher) withdrawLocked(batchOp *crBatchOp) bool {
+	i := slices.Index(b.pending, batchOp)
+	if i < 0 {
+		return false
+	}
+	b.pending = slices.Delete(b.pending, i, i+1)
+	if len(b.pending) == 0 {
+		b.takeLocked()
+	}
+	return true
+}
+
+// takeLocked removes and returns the pending operations. b.mu must be held.
+func (b *crBatcher) takeLocked() []*crBatchOp {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+// flush posts the operations as one transaction and hands each operation its outcome.
+func (b *crBatcher) flush(batch []*crBatchOp) {
+	if len(batch) == 0 {
+		return
+	}
+
+	// The operations come from different requests, whose contexts may already be gone
+	ctx := context.Background()
+
+	crs := make([]any, 0, len(batch))
+	for _, batchOp := range batch {
+		crs = append(crs, batchOp.cr)
+	}
+	description := crTransactionDescription(batch[0].op, batch[0].target)
+	if len(batch) > 1 {
+		description = fmt.Sprintf("terraform: apply %d CRs", len(batch))
+	}
+
+	id, err := b.run(ctx, description, crs)
+
+	for _, batchOp := range batch {
+		batchOp.done <- crBatchResult{id: id, err: crBatchOpError(err, batchOp, len(batch))}
+	}
+}
+
+// crBatchOpError returns the error of a single operation of a failed batch. Errors reported
+// for the CR itself come first, the transaction is atomic so the other errors failed it too.
+func crBatchOpError(err error, batchOp *crBatchOp, batchSize int) error {
+	var txErr *crTransactionError
+	if err == nil || batchSize == 1 || !errors.As(err, &txErr) {
+		return err
+	}
+
+	own := txErr.ByCr[batchOp.target.ID()]
+	if len(own) == 0 {
+		return fmt.Errorf("the change was not applied, another change in the same transaction failed: %w", err)
+	}
+	return fmt.Errorf("transaction %d failed:\n%s\n\nFull transaction errors: %w", txErr.ID, strings.Join(own, "\n"), err)
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCrTransactions records the transactions posted by a crBatcher instead of posting them.
+type fakeCrTransactions struct {
+	mu    sync.Mutex
+	calls [][]any
+	err   error
+	// started and release, if set, hold the transactions until released.
+	started, release chan struct{}
+}
+
+func (f *fakeCrTransactions) run(_ context.Context, _ string, crs []any) (int64, error) {
+	if f.release != nil {
+		f.started <- struct{}{}
+		<-f.release
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, crs)
+	return int64(len(f.calls)), f.err
+}
+
+func (f *fakeCrTransactions) batchSizes() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sizes := []int{}
+	for _, crs := range f.calls {
+		sizes = append(sizes, len(crs))
+	}
+	return sizes
+}
+
+func newTestCrBatcher(maxSize int, window time.Duration, fake *fakeCrTransactions) *crBatcher {
+	b := newCrBatcher(nil, maxSize, window)
+	b.run = fake.run
+	return b
+}
+
+func testCrTarget(name string) crTarget {
+	return newCrTarget("interfaces.eda.nokia.com/v1alpha1", "Interface", "eda", name)
+}
+
+type crBatchOutcome struct {
+	id  int64
+	err error
+}
+
+// submitAll submits one operation per target concurrently and returns their outcomes by target name.
+func submitAll(b *crBatcher, names ...string) map[string]crBatchOutcome {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	outcomes := map[string]crBatchOutcome{}
+	for _, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := b.Submit(context.Background(), "create", testCrTarget(name), map[string]any{"name": name})
+			mu.Lock()
+			outcomes[name] = crBatchOutcome{id: id, err: err}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return outcomes
+}
+
+func TestCrBatcherFlush(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxSize int
+		window  time.Duration
+		ops     int
+		want    []int
+	}{
+		{
+			name:    "flushed when full",
+			maxSize: 3,
+			window:  time.Hour,
+			ops:     3,
+			want:    []int{3},
+		},
+		{
+			name:    "flushed after the window",
+			maxSize: 10,
+			window:  50 * time.Millisecond,
+			ops:     4,
+			want:    []int{4},
+		},
+		{
+			name:    "without batching",
+			maxSize: 1,
+			window:  time.Hour,
+			ops:     3,
+			want:    []int{1, 1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeCrTransactions{}
+			b := newTestCrBatcher(tt.maxSize, tt.window, fake)
+
+			names := []string{}
+			for i := range tt.ops {
+				names = append(names, fmt.Sprintf("if%d", i))
+			}
+			outcomes := submitAll(b, names...)
+
+			for name, outcome := range outcomes {
+				if outcome.err != nil {
+					t.Errorf("Submit(%s) error = %v", name, outcome.err)
+				}
+				if outcome.id == 0 {
+					t.Errorf("Submit(%s) id = 0, want the transaction id", name)
+				}
+			}
+			if got := fake.batchSizes(); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("batch sizes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrBatcherOpError(t *testing.T) {
+	failed := testCrTarget("if0")
+	fake := &fakeCrTransactions{
+		err: &crTransactionError{ID: 7, ByCr: map[string][]string{failed.ID(): {"invalid speed"}}},
+	}
+	b := newTestCrBatcher(2, time.Hour, fake)
+
+	outcomes := submitAll(b, "if0", "if1")
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "if0",
+			want: "transaction 7 failed:\ninvalid speed",
+		},
+		{
+			name: "if1",
+			want: "another change in the same transaction failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := outcomes[tt.name].err
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Submit(%s) error = %v, want it to contain %q", tt.name, err, tt.want)
+			}
+			var txErr *crTransactionError
+			if !errors.As(err, &txErr) {
+				t.Errorf("Submit(%s) error does not wrap the transaction error", tt.name)
+			}
+		})
+	}
+}
+
+func TestCrBatcherCancel(t *testing.T) {
+	fake := &fakeCrTransactions{}
+	b := newTestCrBatcher(10, 50*time.Millisecond, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := b.Submit(ctx, "create", testCrTarget("if0"), map[string]any{"name": "if0"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Submit() error = %v, want %v", err, context.Canceled)
+	}
+
+	// The cancelled operation is withdrawn and never posted, while the next batch is
+	outcomes := submitAll(b, "if1")
+	if err := outcomes["if1"].err; err != nil {
+		t.Fatalf("Submit(if1) error = %v", err)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if len(fake.calls) != 1 || len(fake.calls[0]) != 1 || fmt.Sprint(fake.calls[0][0]) != "map[name:if1]" {
+		t.Errorf("posted transactions = %v, want only the operation on if1", fake.calls)
+	}
+}
+
+func TestCrBatcherCancelWhilePosted(t *testing.T) {
+	fake := &fakeCrTransactions{started: make(chan struct{}, 1), release: make(chan struct{})}
+	b := newTestCrBatcher(2, time.Hour, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := b.Submit(ctx, "create", testCrTarget("if0"), map[string]any{"name": "if0"})
+		cancelled <- err
+	}()
+	other := make(chan error, 1)
+	go func() {
+		_, err := b.Submit(context.Background(), "create", testCrTarget("if1"), map[string]any{"name": "if1"})
+		other <- err
+	}()
+
+	// Once the batch is posted, the operation waits for its outcome even if cancelled
+	<-fake.started
+	cancel()
+	select {
+	case err := <-cancelled:
+		t.Fatalf("Submit() returned %v before the transaction completed", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(fake.release)
+	if err := <-cancelled; err != nil {
+		t.Errorf("Submit(if0) error = %v, want the outcome of the transaction", err)
+	}
+	if err := <-other; err != nil {
+		t.Errorf("Submit(if1) error = %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_cr"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+var (
+	_ datasource.DataSource              = (*crDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*crDataSource)(nil)
+)
+
+func NewCrDataSource() datasource.DataSource {
+	return &crDataSource{}
+}
+
+type crDataSource struct {
+	providerData
+}
+
+func (d *crDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cr"
+}
+
+func (d *crDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_cr.CrDataSourceSchema(ctx)
+}
+
+func (d *crDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_cr.CrModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := newCrTarget(data.ApiVersion.ValueString(), data.Kind.ValueString(),
+		data.Namespace.ValueString(), data.Name.ValueString())
+	crs, err := queryCrs(ctx, d.client, target.Gvk, target.Namespace, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	var found *crItem
+	for i := range crs {
+		if crs[i].Name == target.Name && crs[i].Namespace == target.Namespace {
+			found = &crs[i]
+			break
+		}
+	}
+	if found == nil {
+		resp.Diagnostics.AddError("CR not found", fmt.Sprintf("%s %s was not found", target.Gvk.Kind, crItem{Name: target.Name, Namespace: target.Namespace}.Key()))
+		return
+	}
+
+	// Convert API response to Terraform model
+	for _, field := range []struct {
+		name string
+		val  any
+		dst  *types.Dynamic
+	}{
+		{"metadata", found.Metadata, &data.Metadata},
+		{"spec", found.Spec, &data.Spec},
+		{"status", found.Status, &data.Status},
+	} {
+		*field.dst, err = tfutils.AnyToDynamic(field.val)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(field.name), "Failed to build response from API result", err.Error())
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *crDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
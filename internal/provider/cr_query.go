@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+)
+
+// crItem is a CR as returned by an EQL query on its table.
+type crItem struct {
+	Name      string
+	Namespace string
+	Metadata  map[string]any
+	Spec      any
+	Status    any
+}
+
+// Key returns the key of the CR in the items of the crs data source.
+func (c crItem) Key() string {
+	if c.Namespace == "" {
+		return c.Name
+	}
+	return c.Namespace + "/" + c.Name
+}
+
+// crTable returns the EQL table holding the CRs of the given group, version and kind:
+// .namespace.resources.cr.<group>.<version>.<kind>, with the dots of the group replaced
+// by underscores, "core" for the core group, and the kind in lower case.
+func crTable(gvk crGvk) string {
+	group := strings.ReplaceAll(gvk.Group, ".", "_")
+	if group == "" {
+		group = "core"
+	}
+	return fmt.Sprintf(".namespace.resources.cr.%s.%s.%s", group, gvk.Version, strings.ToLower(gvk.Kind))
+}
+
+// queryCrs runs an EQL query on the table of the CRs, restricted to namespace if set,
+// and to the rows matching where if set.
+func queryCrs(ctx context.Context, client *apiclient.EdaApiClient, gvk crGvk, namespace, where string) ([]crItem, error) {
+	query := crTable(gvk)
+	if where != "" {
+		query += " where (" + where + ")"
+	}
+	queryParams := url.Values{"query": {query}}
+	if namespace != "" {
+		queryParams.Set("namespaces", namespace)
+	}
+
+	tflog.Info(ctx, "queryCrs()::API request", map[string]any{
+		"path":  read_ds_eqlStreamResult,
+		"query": queryParams,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := client.GetByQuery(ctx, read_ds_eqlStreamResult, nil, queryParams, &result)
+
+	tflog.Info(ctx, "queryCrs()::API returned", map[string]any{
+		"path":      read_ds_eqlStreamResult,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, _ := result["data"].([]any)
+	items := make([]crItem, 0, len(rows))
+	for _, row := range rows {
+		rowMap, ok := row.(map[string]any)
+		if !ok {
+			continue
+		}
+		items = append(items, decodeCrRow(rowMap))
+	}
+	return items, nil
+}
+
+// decodeCrRow decodes a row of a CR table. The name and namespace are taken from the
+// metadata, or from the row itself when the metadata does not carry them.
+func decodeCrRow(row map[string]any) crItem {
+	metadata, _ := row["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		name, _ = row["name"].(string)
+	}
+	namespace, _ := metadata["namespace"].(string)
+	if namespace == "" {
+		namespace, _ = row["namespace.name"].(string)
+	}
+	return crItem{
+		Name:      name,
+		Namespace: namespace,
+		Metadata:  metadata,
+		Spec:      row["spec"],
+		Status:    row["status"],
+	}
+}
+
+// labelRequirement is a single requirement of a label selector.
+type labelRequirement struct {
+	key    string
+	op     string
+	values []string
+}
+
+// labelSelector is a parsed Kubernetes label selector, all requirements must match.
+type labelSelector []labelRequirement
+
+var labelSetRequirementRe = regexp.MustCompile(`^(\S+)\s+(in|notin)\s+\((.*)\)$`)
+
+// parseLabelSelector parses the equality and set based Kubernetes label selector syntax:
+// "key=value", "key==value", "key!=value", "key", "!key", "key in (a,b)" and "key notin (a,b)".
+func parseLabelSelector(selector string) (labelSelector, error) {
+	sel := labelSelector{}
+	for _, part := range splitLabelSelector(selector) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		var req labelRequirement
+		switch {
+		case labelSetRequirementRe.MatchString(part):
+			m := labelSetRequirementRe.FindStringSubmatch(part)
+			req = labelRequirement{key: m[1], op: m[2]}
+			for _, v := range strings.Split(m[3], ",") {
+				req.values = append(req.values, strings.TrimSpace(v))
+			}
+		case strings.Contains(part, "!="):
+			key, value, _ := strings.Cut(part, "!=")
+			req = labelRequirement{key: strings.TrimSpace(key), op: "!=", values: []string{strings.TrimSpace(value)}}
+		case strings.Contains(part, "="):
+			key, value, _ := strings.Cut(strings.Replace(part, "==", "=", 1), "=")
+			req = labelRequirement{key: strings.TrimSpace(key), op: "=", values: []string{strings.TrimSpace(value)}}
+		case strings.HasPrefix(part, "!"):
+			req = labelRequirement{key: strings.TrimSpace(part[1:]), op: "!"}
+		default:
+			req = labelRequirement{key: part, op: "exists"}
+		}
+		if err := validateLabelKey(req.key); err != nil {
+			return nil, fmt.Errorf("invalid label selector requirement %q: %w", part, err)
+		}
+		for _, value := range req.values {
+			if err := validateLabelValue(req.key, value); err != nil {
+				return nil, fmt.Errorf("invalid label selector requirement %q: %w", part, err)
+			}
+		}
+		sel = append(sel, req)
+	}
+	return sel, nil
+}
+
+// splitLabelSelector splits a selector on the commas that are not within parentheses.
+func splitLabelSelector(selector string) []string {
+	parts := []string{}
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+// Matches reports whether the labels satisfy every requirement of the selector.
+func (sel labelSelector) Matches(labels map[string]any) bool {
+	for _, req := range sel {
+		value, found := labels[req.key].(string)
+		switch req.op {
+		case "exists":
+			if !found {
+				return false
+			}
+		case "!":
+			if found {
+				return false
+			}
+		case "=":
+			if !found || value != req.values[0] {
+				return false
+			}
+		case "!=":
+			if found && value == req.values[0] {
+				return false
+			}
+		case "in":
+			if !found || !slices.Contains(req.values, value) {
+				return false
+			}
+		case "notin":
+			if found && slices.Contains(req.values, value) {
+				return false
+			}
+		}
+	}
+	return true
+}
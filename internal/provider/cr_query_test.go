@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCrTable(t *testing.T) {
+	tests := []struct {
+		name string
+		gvk  crGvk
+		want string
+	}{
+		{
+			name: "dotted group",
+			gvk:  crGvk{Group: "interfaces.eda.nokia.com", Version: "v1alpha1", Kind: "Interface"},
+			want: ".namespace.resources.cr.interfaces_eda_nokia_com.v1alpha1.interface",
+		},
+		{
+			name: "group without dots",
+			gvk:  crGvk{Group: "apps", Version: "v1", Kind: "Deployment"},
+			want: ".namespace.resources.cr.apps.v1.deployment",
+		},
+		{
+			name: "core group",
+			gvk:  crGvk{Version: "v1", Kind: "ConfigMap"},
+			want: ".namespace.resources.cr.core.v1.configmap",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := crTable(tt.gvk); got != tt.want {
+				t.Errorf("crTable(%+v) = %s, want %s", tt.gvk, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryCrs(t *testing.T) {
+	gvk := crGvk{Group: "interfaces.eda.nokia.com", Version: "v1alpha1", Kind: "Interface"}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		where         string
+		wantQuery     string
+		wantNamespace string
+	}{
+		{
+			name:      "all namespaces",
+			wantQuery: ".namespace.resources.cr.interfaces_eda_nokia_com.v1alpha1.interface",
+		},
+		{
+			name:          "namespace and where clause",
+			namespace:     "eda",
+			where:         `spec.enabled = true`,
+			wantQuery:     `.namespace.resources.cr.interfaces_eda_nokia_com.v1alpha1.interface where (spec.enabled = true)`,
+			wantNamespace: "eda",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query, namespace string
+			pd := newTestProviderData(t, func(w http.ResponseWriter, req *http.Request) {
+				query = req.URL.Query().Get("query")
+				namespace = req.URL.Query().Get("namespaces")
+				writeJSON(w, http.StatusOK, `{"data": [
+					{"metadata": {"name": "leaf1-ethernet-1-1", "namespace": "eda"}, "spec": {"enabled": true}},
+					{"name": "leaf1-ethernet-1-2", "namespace.name": "eda", "spec": {"enabled": true}, "status": {"operationalState": "up"}}
+				]}`)
+			})
+
+			items, err := queryCrs(context.Background(), pd.client, gvk, tt.namespace, tt.where)
+			if err != nil {
+				t.Fatalf("queryCrs() error = %v", err)
+			}
+			if query != tt.wantQuery || namespace != tt.wantNamespace {
+				t.Errorf("queryCrs() query = %q in %q, want %q in %q", query, namespace, tt.wantQuery, tt.wantNamespace)
+			}
+			keys := []string{}
+			for _, item := range items {
+				keys = append(keys, item.Key())
+			}
+			if want := []string{"eda/leaf1-ethernet-1-1", "eda/leaf1-ethernet-1-2"}; !reflect.DeepEqual(keys, want) {
+				t.Errorf("queryCrs() keys = %v, want %v", keys, want)
+			}
+		})
+	}
+}
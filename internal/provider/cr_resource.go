@@ -0,0 +1,417 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_cr"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// crImportedKey marks, in private state, a CR that was just imported, so that
+// the first read takes the full live spec instead of only the managed fields.
+const crImportedKey = "imported"
+
+var crObjectAsOptions = basetypes.ObjectAsOptions{}
+
+var (
+	_ resource.Resource                = (*crResource)(nil)
+	_ resource.ResourceWithConfigure   = (*crResource)(nil)
+	_ resource.ResourceWithImportState = (*crResource)(nil)
+)
+
+func NewCrResource() resource.Resource {
+	return &crResource{}
+}
+
+// crResource manages a single CR of any kind. Its changes are posted through the
+// batcher, so CRs applied together end up in a single transaction.
+type crResource struct {
+	providerData
+}
+
+func (r *crResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cr"
+}
+
+func (r *crResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_cr.CrResourceSchema(ctx)
+}
+
+func (r *crResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_cr.CrModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, "create", &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save created data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_cr.CrModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var metadata resource_cr.MetadataModel
+	resp.Diagnostics.Append(data.Metadata.As(ctx, &metadata, crObjectAsOptions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, crImportedKey)
+	resp.Diagnostics.Append(diags...)
+
+	target := newCrTarget(data.ApiVersion.ValueString(), data.Kind.ValueString(),
+		metadata.Namespace.ValueString(), metadata.Name.ValueString())
+	crs, err := readCrs(ctx, r.client, []crTarget{target})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+	if crs[0] == nil {
+		// The CR was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(refreshCr(ctx, &data, &metadata, crs[0], imported != nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, crImportedKey, nil)...)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_cr.CrModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, "replace", &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_cr.CrModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var metadata resource_cr.MetadataModel
+	resp.Diagnostics.Append(data.Metadata.As(ctx, &metadata, crObjectAsOptions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := newCrTarget(data.ApiVersion.ValueString(), data.Kind.ValueString(),
+		metadata.Namespace.ValueString(), metadata.Name.ValueString())
+	_, err := r.batcher.Submit(ctx, "delete", target, map[string]any{
+		"type": map[string]any{"delete": target},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting resource", err.Error())
+		return
+	}
+}
+
+// apply creates or replaces the CR through the batcher, and sets the
+// id and transaction_id of the model.
+func (r *crResource) apply(ctx context.Context, data *resource_cr.CrModel, op string, diags *diag.Diagnostics) {
+	content, target, d := crContent(ctx, data)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return
+	}
+
+	id, err := r.batcher.Submit(ctx, op, target, map[string]any{
+		"type": map[string]any{op: map[string]any{"value": content}},
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error applying resource (%s)", op), err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(target.ID())
+	data.TransactionId = types.Int64Value(id)
+}
+
+// crContent builds the TransactionContent of the CR from the model, along with its target.
+func crContent(ctx context.Context, data *resource_cr.CrModel) (map[string]any, crTarget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var metadata resource_cr.MetadataModel
+	diags.Append(data.Metadata.As(ctx, &metadata, crObjectAsOptions)...)
+
+	if diags.HasError() {
+		return nil, crTarget{}, diags
+	}
+
+	target := newCrTarget(data.ApiVersion.ValueString(), data.Kind.ValueString(),
+		metadata.Namespace.ValueString(), metadata.Name.ValueString())
+
+	meta := map[string]any{"name": metadata.Name.ValueString()}
+	if !metadata.Namespace.IsNull() {
+		meta["namespace"] = metadata.Namespace.ValueString()
+	}
+	for key, m := range map[string]types.Map{"labels": metadata.Labels, "annotations": metadata.Annotations} {
+		if m.IsNull() {
+			continue
+		}
+		values := map[string]string{}
+		diags.Append(m.ElementsAs(ctx, &values, false)...)
+		meta[key] = values
+	}
+
+	spec, err := tfutils.DynamicToAny(ctx, data.Spec)
+	if err != nil {
+		diags.AddAttributeError(path.Root("spec"), "Invalid spec", err.Error())
+		return nil, target, diags
+	}
+
+	content := map[string]any{
+		"apiVersion": data.ApiVersion.ValueString(),
+		"kind":       data.Kind.ValueString(),
+		"metadata":   meta,
+	}
+	if spec != nil {
+		content["spec"] = spec
+	}
+	return content, target, diags
+}
+
+// refreshCr updates the model from the live CR. Only the fields that are present in the
+// prior state are compared, so that defaults filled in by EDA do not show up as drift,
+// unless full is set, in which case the whole live CR is taken, as after an import.
+func refreshCr(ctx context.Context, data *resource_cr.CrModel, metadata *resource_cr.MetadataModel, live map[string]any, full bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	liveMeta, _ := live["metadata"].(map[string]any)
+	for _, m := range []struct {
+		key string
+		val *types.Map
+	}{{"labels", &metadata.Labels}, {"annotations", &metadata.Annotations}} {
+		liveVals, _ := liveMeta[m.key].(map[string]any)
+		if m.val.IsNull() && (!full || len(liveVals) == 0) {
+			continue
+		}
+		priorVals := map[string]string{}
+		diags.Append(m.val.ElementsAs(ctx, &priorVals, false)...)
+
+		values := map[string]string{}
+		for k, v := range liveVals {
+			_, managed := priorVals[k]
+			if s, ok := v.(string); ok && (managed || full) {
+				values[k] = s
+			}
+		}
+		mapVal, d := types.MapValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		*m.val = mapVal
+	}
+
+	metaVal, d := types.ObjectValueFrom(ctx, resource_cr.MetadataAttrTypes, metadata)
+	diags.Append(d...)
+	data.Metadata = metaVal
+
+	prior, err := tfutils.DynamicToAny(ctx, data.Spec)
+	if err != nil {
+		diags.AddAttributeError(path.Root("spec"), "Invalid spec", err.Error())
+		return diags
+	}
+	liveSpec := live["spec"]
+	if !full {
+		// A spec that is not configured is not managed
+		if prior == nil {
+			return diags
+		}
+		liveSpec = projectCrValue(prior, liveSpec)
+	}
+
+	// Keep the prior value as is when nothing changed, so that its types are preserved
+	if crValuesEqual(prior, liveSpec) {
+		return diags
+	}
+	tflog.Info(ctx, "refreshCr()::Spec differs from live state", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+	spec, err := tfutils.AnyToDynamic(liveSpec)
+	if err != nil {
+		diags.AddAttributeError(path.Root("spec"), "Failed to build spec from API result", err.Error())
+		return diags
+	}
+	data.Spec = spec
+	return diags
+}
+
+// projectCrValue returns the parts of live that are also present in prior.
+// Arrays are projected element by element, extra live elements are kept.
+func projectCrValue(prior, live any) any {
+	switch p := prior.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+		projected := map[string]any{}
+		for k, v := range p {
+			if lv, found := l[k]; found {
+				projected[k] = projectCrValue(v, lv)
+			}
+		}
+		return projected
+	case []any:
+		l, ok := live.([]any)
+		if !ok {
+			return live
+		}
+		projected := make([]any, len(l))
+		for i, lv := range l {
+			if i < len(p) {
+				projected[i] = projectCrValue(p[i], lv)
+			} else {
+				projected[i] = lv
+			}
+		}
+		return projected
+	default:
+		return live
+	}
+}
+
+// crValuesEqual compares two values after normalizing them through JSON,
+// so that for example int64 and float64 numbers compare equal.
+func crValuesEqual(a, b any) bool {
+	normalize := func(v any) any {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return v
+		}
+		var out any
+		if json.Unmarshal(raw, &out) != nil {
+			return v
+		}
+		return out
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func crTransactionDescription(op string, target crTarget) string {
+	name := target.Name
+	if target.Namespace != "" {
+		name = target.Namespace + "/" + name
+	}
+	return fmt.Sprintf("terraform: %s %s %s", op, target.Gvk.Kind, name)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *crResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *crResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	target, err := parseCrImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	apiVersion := target.Gvk.Version
+	if target.Gvk.Group != "" {
+		apiVersion = target.Gvk.Group + "/" + target.Gvk.Version
+	}
+	namespace := types.StringNull()
+	if target.Namespace != "" {
+		namespace = types.StringValue(target.Namespace)
+	}
+	metaVal, diags := types.ObjectValueFrom(ctx, resource_cr.MetadataAttrTypes, resource_cr.MetadataModel{
+		Annotations: types.MapNull(types.StringType),
+		Labels:      types.MapNull(types.StringType),
+		Name:        types.StringValue(target.Name),
+		Namespace:   namespace,
+	})
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_version"), apiVersion)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kind"), target.Gvk.Kind)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata"), metaVal)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, crImportedKey, []byte(`true`))...)
+}
+
+// parseCrImportID returns the target of an import id made by crTarget.ID. The group is empty
+// for CRs in the core group, and the namespace for cluster-scoped CRs.
+func parseCrImportID(id string) (crTarget, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 5 || parts[1] == "" || parts[2] == "" || parts[4] == "" {
+		return crTarget{}, fmt.Errorf("Expected id = <group>/<version>/<kind>/<namespace>/<name> format, got: id = %s", id)
+	}
+	return crTarget{
+		Gvk:       crGvk{Group: parts[0], Version: parts[1], Kind: parts[2]},
+		Name:      parts[4],
+		Namespace: parts[3],
+	}, nil
+}
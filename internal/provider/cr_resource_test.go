@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_cr"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+func TestProjectCrValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior any
+		live  any
+		want  any
+	}{
+		{
+			name:  "fields defaulted by EDA are dropped",
+			prior: map[string]any{"enabled": true},
+			live:  map[string]any{"enabled": false, "mtu": float64(9000)},
+			want:  map[string]any{"enabled": false},
+		},
+		{
+			name:  "nested maps",
+			prior: map[string]any{"lldp": map[string]any{"enabled": true}},
+			live:  map[string]any{"lldp": map[string]any{"enabled": true, "interval": float64(30)}, "mtu": float64(9000)},
+			want:  map[string]any{"lldp": map[string]any{"enabled": true}},
+		},
+		{
+			name:  "fields missing from the live CR are dropped",
+			prior: map[string]any{"description": "uplink", "enabled": true},
+			live:  map[string]any{"enabled": true},
+			want:  map[string]any{"enabled": true},
+		},
+		{
+			name:  "list elements are projected one by one",
+			prior: map[string]any{"members": []any{map[string]any{"interface": "ethernet-1-1"}}},
+			live: map[string]any{"members": []any{
+				map[string]any{"interface": "ethernet-1-1", "lacpPortPriority": float64(32768)},
+				map[string]any{"interface": "ethernet-1-2", "lacpPortPriority": float64(32768)},
+			}},
+			want: map[string]any{"members": []any{
+				map[string]any{"interface": "ethernet-1-1"},
+				map[string]any{"interface": "ethernet-1-2", "lacpPortPriority": float64(32768)},
+			}},
+		},
+		{
+			name:  "type change is taken as is",
+			prior: map[string]any{"vlan": map[string]any{"id": float64(10)}},
+			live:  map[string]any{"vlan": "untagged"},
+			want:  map[string]any{"vlan": "untagged"},
+		},
+		{
+			name:  "numbers",
+			prior: float64(1),
+			live:  int64(2),
+			want:  int64(2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := projectCrValue(tt.prior, tt.live); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("projectCrValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrValuesEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b any
+		want bool
+	}{
+		{
+			name: "int64 and float64",
+			a:    map[string]any{"mtu": int64(9000)},
+			b:    map[string]any{"mtu": float64(9000)},
+			want: true,
+		},
+		{
+			name: "different numbers",
+			a:    map[string]any{"mtu": int64(9000)},
+			b:    map[string]any{"mtu": float64(1500)},
+		},
+		{
+			name: "nested maps and lists",
+			a:    map[string]any{"members": []any{map[string]any{"interface": "ethernet-1-1", "priority": int64(1)}}},
+			b:    map[string]any{"members": []any{map[string]any{"priority": float64(1), "interface": "ethernet-1-1"}}},
+			want: true,
+		},
+		{
+			name: "list order",
+			a:    []any{"a", "b"},
+			b:    []any{"b", "a"},
+		},
+		{
+			name: "missing field",
+			a:    map[string]any{"enabled": true, "mtu": int64(9000)},
+			b:    map[string]any{"enabled": true},
+		},
+		{
+			name: "null and empty map",
+			a:    nil,
+			b:    map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := crValuesEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("crValuesEqual(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefreshCr(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{
+			"name":   "leaf1-ethernet-1-1",
+			"labels": map[string]any{"role": "uplink", "eda.nokia.com/owner": "fabric"},
+		},
+		"spec": map[string]any{
+			"enabled": true,
+			"lldp":    map[string]any{"enabled": true, "interval": float64(30)},
+			"mtu":     float64(9000),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		spec       any
+		labels     map[string]string
+		live       map[string]any
+		full       bool
+		wantSpec   any
+		wantLabels map[string]string
+	}{
+		{
+			name:       "managed fields only",
+			spec:       map[string]any{"enabled": true, "lldp": map[string]any{"enabled": true}},
+			labels:     map[string]string{"role": "uplink"},
+			live:       live,
+			wantSpec:   map[string]any{"enabled": true, "lldp": map[string]any{"enabled": true}},
+			wantLabels: map[string]string{"role": "uplink"},
+		},
+		{
+			name:       "drift of a managed field",
+			spec:       map[string]any{"mtu": int64(1500)},
+			live:       live,
+			wantSpec:   map[string]any{"mtu": float64(9000)},
+			wantLabels: nil,
+		},
+		{
+			name:     "spec not configured",
+			live:     live,
+			wantSpec: nil,
+		},
+		{
+			name:       "full after import",
+			live:       live,
+			full:       true,
+			wantSpec:   live["spec"],
+			wantLabels: map[string]string{"role": "uplink", "eda.nokia.com/owner": "fabric"},
+		},
+		{
+			name:       "full with a prior spec",
+			spec:       map[string]any{"enabled": true},
+			labels:     map[string]string{"role": "uplink"},
+			live:       live,
+			full:       true,
+			wantSpec:   live["spec"],
+			wantLabels: map[string]string{"role": "uplink", "eda.nokia.com/owner": "fabric"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			spec, err := tfutils.AnyToDynamic(tt.spec)
+			if err != nil {
+				t.Fatalf("AnyToDynamic() error = %v", err)
+			}
+			labels := types.MapNull(types.StringType)
+			if tt.labels != nil {
+				labels, _ = types.MapValueFrom(ctx, types.StringType, tt.labels)
+			}
+			data := &resource_cr.CrModel{Spec: spec}
+			metadata := &resource_cr.MetadataModel{
+				Annotations: types.MapNull(types.StringType),
+				Labels:      labels,
+				Name:        types.StringValue("leaf1-ethernet-1-1"),
+				Namespace:   types.StringValue("eda"),
+			}
+
+			if diags := refreshCr(ctx, data, metadata, tt.live, tt.full); diags.HasError() {
+				t.Fatalf("refreshCr() diagnostics = %v", diags)
+			}
+
+			gotSpec, err := tfutils.DynamicToAny(ctx, data.Spec)
+			if err != nil {
+				t.Fatalf("DynamicToAny() error = %v", err)
+			}
+			if !crValuesEqual(gotSpec, tt.wantSpec) {
+				t.Errorf("refreshCr() spec = %v, want %v", gotSpec, tt.wantSpec)
+			}
+			var gotLabels map[string]string
+			if !metadata.Labels.IsNull() {
+				metadata.Labels.ElementsAs(ctx, &gotLabels, false)
+			}
+			if !reflect.DeepEqual(gotLabels, tt.wantLabels) {
+				t.Errorf("refreshCr() labels = %v, want %v", gotLabels, tt.wantLabels)
+			}
+			if !metadata.Annotations.IsNull() {
+				t.Errorf("refreshCr() annotations = %v, want null", metadata.Annotations)
+			}
+		})
+	}
+}
+
+func TestParseCrImportID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    crTarget
+		wantErr bool
+	}{
+		{
+			name: "namespaced CR",
+			id:   "interfaces.eda.nokia.com/v1alpha1/Interface/eda/leaf1-ethernet-1-1",
+			want: crTarget{
+				Gvk:       crGvk{Group: "interfaces.eda.nokia.com", Version: "v1alpha1", Kind: "Interface"},
+				Name:      "leaf1-ethernet-1-1",
+				Namespace: "eda",
+			},
+		},
+		{
+			name: "core group",
+			id:   "/v1/ConfigMap/eda/settings",
+			want: crTarget{
+				Gvk:       crGvk{Version: "v1", Kind: "ConfigMap"},
+				Name:      "settings",
+				Namespace: "eda",
+			},
+		},
+		{
+			name: "cluster-scoped CR",
+			id:   "core.eda.nokia.com/v1/Namespace//eda",
+			want: crTarget{
+				Gvk:  crGvk{Group: "core.eda.nokia.com", Version: "v1", Kind: "Namespace"},
+				Name: "eda",
+			},
+		},
+		{
+			name:    "missing version",
+			id:      "core.eda.nokia.com//Namespace//eda",
+			wantErr: true,
+		},
+		{
+			name:    "missing kind",
+			id:      "core.eda.nokia.com/v1//eda/settings",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			id:      "core.eda.nokia.com/v1/Namespace/eda/",
+			wantErr: true,
+		},
+		{
+			name:    "missing segment",
+			id:      "v1/ConfigMap/eda/settings",
+			wantErr: true,
+		},
+		{
+			name:    "extra segment",
+			id:      "interfaces.eda.nokia.com/v1alpha1/Interface/eda/leaf1/ethernet-1-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCrImportID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCrImportID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseCrImportID(%q) = %+v, want %+v", tt.id, got, tt.want)
+			}
+			// The import id is the id set by the resource
+			if !tt.wantErr && got.ID() != tt.id {
+				t.Errorf("parseCrImportID(%q).ID() = %q", tt.id, got.ID())
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	read_transactionSummary   = "/core/transaction/v2/result/summary/{transactionId}"
+	read_transactionExecution = "/core/transaction/v2/result/execution/{transactionId}"
+	read_transactionResources = "/core/transaction/v3/resources"
+)
+
+// crGvk is the group, version and kind of a CR.
+type crGvk struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+// crTarget identifies a single CR, in the NsCrGvkName form used by transactions.
+type crTarget struct {
+	Gvk       crGvk  `json:"gvk"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// newCrTarget builds the target of a CR from its apiVersion, which is either
+// "group/version", or only "version" for CRs in the core group.
+func newCrTarget(apiVersion, kind, namespace, name string) crTarget {
+	group, version, found := strings.Cut(apiVersion, "/")
+	if !found {
+		group, version = "", apiVersion
+	}
+	return crTarget{
+		Gvk:       crGvk{Group: group, Version: version, Kind: kind},
+		Name:      name,
+		Namespace: namespace,
+	}
+}
+
+// ID returns the import id of the CR, group/version/kind/namespace/name.
+func (t crTarget) ID() string {
+	return strings.Join([]string{t.Gvk.Group, t.Gvk.Version, t.Gvk.Kind, t.Namespace, t.Name}, "/")
+}
+
+// runCrTransaction posts a transaction with the given CR operations and waits for it
+// to complete. A transaction that completes unsuccessfully is returned as an error
+// that carries the errors reported for it.
+func runCrTransaction(ctx context.Context, client *apiclient.EdaApiClient, description string, crs []any) (int64, error) {
+	reqBody := map[string]any{
+		"crs":         crs,
+		"description": description,
+		"dryRun":      false,
+	}
+
+	tflog.Info(ctx, "runCrTransaction()::API request", map[string]any{
+		"path": create_transaction,
+		"body": spew.Sdump(reqBody),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := client.Create(ctx, create_transaction, nil, reqBody, &result)
+
+	tflog.Info(ctx, "runCrTransaction()::API returned", map[string]any{
+		"path":      create_transaction,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	anyVal, ok := result["id"]
+	if !ok {
+		return 0, fmt.Errorf("transaction id missing from result")
+	}
+	id, err := tfutils.NumToInt64(anyVal)
+	if err != nil {
+		return 0, err
+	}
+
+	pathParams := map[string]string{"transactionId": strconv.FormatInt(id, 10)}
+	summary := map[string]any{}
+	err = client.GetByQuery(ctx, read_transactionSummary, pathParams, url.Values{
+		"waitForComplete": {"true"},
+	}, &summary)
+
+	tflog.Info(ctx, "runCrTransaction()::Transaction completed", map[string]any{
+		"id":        id,
+		"summary":   spew.Sdump(summary),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		return id, fmt.Errorf("failed to get result of transaction %d: %w", id, err)
+	}
+	if success, _ := summary["success"].(bool); success {
+		return id, nil
+	}
+
+	return id, newCrTransactionError(ctx, client, id, pathParams)
+}
+
+// crTransactionError is returned for a transaction that completed unsuccessfully.
+// It carries the general errors of the transaction, and the intent errors keyed
+// by the id of the CR they were reported for.
+type crTransactionError struct {
+	ID      int64
+	General []string
+	ByCr    map[string][]string
+}
+
+func (e *crTransactionError) Error() string {
+	errs := append([]string{}, e.General...)
+	for crId, crErrs := range e.ByCr {
+		for _, crErr := range crErrs {
+			errs = append(errs, fmt.Sprintf("%s: %s", crId, crErr))
+		}
+	}
+	if len(errs) == 0 {
+		return fmt.Sprintf("transaction %d failed", e.ID)
+	}
+	return fmt.Sprintf("transaction %d failed:\n%s", e.ID, strings.Join(errs, "\n"))
+}
+
+// newCrTransactionError collects the general and intent errors of a failed transaction.
+func newCrTransactionError(ctx context.Context, client *apiclient.EdaApiClient, id int64, pathParams map[string]string) *crTransactionError {
+	txErr := &crTransactionError{ID: id, ByCr: map[string][]string{}}
+
+	execution := transactionExecutionResult{}
+	err := client.Get(ctx, read_transactionExecution, pathParams, &execution)
+	if err != nil {
+		tflog.Warn(ctx, "newCrTransactionError()::Failed to get execution result", map[string]any{
+			"error": err.Error(),
+		})
+		return txErr
+	}
+
+	txErr.General = execution.GeneralErrors
+	for _, intent := range execution.IntentsRun {
+		for _, intentErr := range intent.Errors {
+			msg := intentErr.Error.Message
+			if msg == "" {
+				msg = intentErr.RawError
+			}
+			crId := intent.IntentName.ID()
+			txErr.ByCr[crId] = append(txErr.ByCr[crId], msg)
+		}
+	}
+	return txErr
+}
+
+type transactionExecutionResult struct {
+	GeneralErrors []string `json:"generalErrors"`
+	IntentsRun    []struct {
+		IntentName crTarget `json:"intentName"`
+		Errors     []struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+			RawError string `json:"rawError"`
+		} `json:"errors"`
+	} `json:"intentsRun"`
+}
+
+// readCrs returns the current state of the given CRs, in the same order.
+// A CR that does not exist is returned as nil.
+func readCrs(ctx context.Context, client *apiclient.EdaApiClient, targets []crTarget) ([]map[string]any, error) {
+	tflog.Info(ctx, "readCrs()::API request", map[string]any{
+		"path":    read_transactionResources,
+		"targets": spew.Sdump(targets),
+	})
+
+	t0 := time.Now()
+	result := []map[string]any{}
+
+	err := client.Create(ctx, read_transactionResources, nil, targets, &result)
+
+	tflog.Info(ctx, "readCrs()::API returned", map[string]any{
+		"path":      read_transactionResources,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != len(targets) {
+		return nil, fmt.Errorf("expected %d resources, got %d", len(targets), len(result))
+	}
+
+	crs := make([]map[string]any, len(result))
+	for i, res := range result {
+		crs[i], _ = res["cr"].(map[string]any)
+	}
+	return crs, nil
+}
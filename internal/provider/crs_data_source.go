@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_crs"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+var (
+	_ datasource.DataSource                   = (*crsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*crsDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*crsDataSource)(nil)
+)
+
+func NewCrsDataSource() datasource.DataSource {
+	return &crsDataSource{}
+}
+
+type crsDataSource struct {
+	providerData
+}
+
+func (d *crsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crs"
+}
+
+func (d *crsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_crs.CrsDataSourceSchema(ctx)
+}
+
+func (d *crsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_crs.CrsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selector, err := parseLabelSelector(data.LabelSelector.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("label_selector"), "Invalid label selector", err.Error())
+		return
+	}
+
+	target := newCrTarget(data.ApiVersion.ValueString(), data.Kind.ValueString(), "", "")
+	crs, err := queryCrs(ctx, d.client, target.Gvk, data.Namespace.ValueString(), data.FieldSelector.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model, keyed so that items can be used with for_each
+	items := map[string]any{}
+	names := []string{}
+	for _, cr := range crs {
+		labels, _ := cr.Metadata["labels"].(map[string]any)
+		if !selector.Matches(labels) {
+			continue
+		}
+		items[cr.Key()] = crItemValue(cr)
+		names = append(names, cr.Key())
+	}
+	sort.Strings(names)
+
+	data.Items, err = tfutils.AnyToDynamic(items)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+		return
+	}
+	namesVal, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	data.Names = namesVal
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// crItemValue returns the value of a CR in the items of the crs data source.
+func crItemValue(cr crItem) map[string]any {
+	return map[string]any{
+		"name":      cr.Name,
+		"namespace": cr.Namespace,
+		"metadata":  cr.Metadata,
+		"spec":      cr.Spec,
+		"status":    cr.Status,
+	}
+}
+
+// ValidateConfig checks the syntax of the query inputs during plan.
+func (d *crsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateQueryAttribute(ctx, d.client, eqlWhereCheck, req.Config, path.Root("field_selector"), &resp.Diagnostics)
+	validateLabelSelectorAttribute(ctx, req.Config, path.Root("label_selector"), &resp.Diagnostics)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *crsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// crudPaths are the API paths of the operations of a crudResource.
+type crudPaths struct {
+	Create, Read, Update, Delete string
+}
+
+// crudResource is a resource whose create, read, update and delete operations are API
+// operations on the same object, with M the Terraform model of the resource.
+type crudResource[M any] struct {
+	// typeName is appended to the provider type name, such as _auth_role.
+	typeName string
+	schema   func(context.Context) schema.Schema
+	paths    crudPaths
+	// identity returns the path parameters of the object of the model, by name.
+	identity func(data *M) map[string]string
+	// target returns the model the API values are converted from and to, the model itself
+	// if nil, or the generated model embedded in a custom one.
+	target func(data *M) any
+	// fieldModes are the attributes that are not merged from the API responses.
+	fieldModes tfutils.FieldModes
+	// reread is true if the create and update responses do not hold the object, which is
+	// then read again.
+	reread bool
+	// keepState copies the attributes the plan does not know from the prior state on update,
+	// such as the identity assigned by the API on creation.
+	keepState func(data, state *M)
+	// beforeCreate and beforeUpdate edit the request body built from the model.
+	beforeCreate, beforeUpdate func(ctx context.Context, data *M, body map[string]any) error
+	// afterMerge edits the model merged from the create, read or update response.
+	afterMerge func(ctx context.Context, data *M)
+	// parseImportID returns the values of the attributes of an import ID, by name.
+	parseImportID func(id string) (map[string]string, error)
+
+	providerData
+}
+
+var (
+	_ resource.Resource                = (*crudResource[struct{}])(nil)
+	_ resource.ResourceWithConfigure   = (*crudResource[struct{}])(nil)
+	_ resource.ResourceWithImportState = (*crudResource[struct{}])(nil)
+)
+
+// splitImportID returns the parser of import IDs made of the values of attrs joined with slashes.
+func splitImportID(attrs ...string) func(id string) (map[string]string, error) {
+	return func(id string) (map[string]string, error) {
+		parts := strings.Split(id, "/")
+		if len(parts) != len(attrs) {
+			return nil, fmt.Errorf("Expected format: id = <%s>, got: id = %s", strings.Join(attrs, "/"), id)
+		}
+		values := make(map[string]string, len(attrs))
+		for i, attr := range attrs {
+			values[attr] = parts[i]
+		}
+		return values, nil
+	}
+}
+
+func (r *crudResource[M]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *crudResource[M]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
+}
+
+func (r *crudResource[M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Initialize unknown values with null defaults
+	err := tfutils.FillMissingValues(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filling missing values", err.Error())
+		return
+	}
+
+	// Convert Terraform model to API request body
+	reqBody, err := oasConverter(http.MethodPost, r.paths.Create).ModelToAnyMap(ctx, r.model(&data))
+	if err != nil {
+		tfutils.AddConversionError(&resp.Diagnostics, "Error building request", err)
+		return
+	}
+	if r.beforeCreate != nil {
+		if err := r.beforeCreate(ctx, &data, reqBody); err != nil {
+			resp.Diagnostics.AddError("Error building request", err.Error())
+			return
+		}
+	}
+
+	// Create API call logic
+	tflog.Info(ctx, "Create()::API request", map[string]any{
+		"path": r.paths.Create,
+		"body": spew.Sdump(reqBody),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err = r.client.Create(ctx, r.paths.Create, r.pathParams(r.paths.Create, &data), reqBody, &result)
+
+	tflog.Info(ctx, "Create()::API returned", map[string]any{
+		"path":      r.paths.Create,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", err.Error())
+		return
+	}
+
+	if r.reread {
+		// Read the resource again to populate any values not available in the response from Create()
+		if result, err = r.get(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Error reading resource", err.Error())
+			return
+		}
+	}
+
+	// Merge API response into Terraform model, keeping attributes the API does not return
+	err = r.oasDecoder(http.MethodGet, r.paths.Read).MergeAnyMapToModel(ctx, result, r.model(&data), r.fieldModes)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	if r.afterMerge != nil {
+		r.afterMerge(ctx, &data)
+	}
+
+	// Save created data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *crudResource[M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data M
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": r.paths.Read,
+		"data": spew.Sdump(data),
+	})
+
+	result, err := r.get(ctx, &data)
+	if apiclient.IsNotFound(err) {
+		// The resource was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Merge API response into Terraform model, keeping attributes the API does not return
+	err = r.oasDecoder(http.MethodGet, r.paths.Read).MergeAnyMapToModel(ctx, result, r.model(&data), r.fieldModes)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	if r.afterMerge != nil {
+		r.afterMerge(ctx, &data)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crudResource[M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.keepState != nil {
+		r.keepState(&data, &state)
+	}
+
+	err := tfutils.FillMissingValues(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error filling missing values", err.Error())
+		return
+	}
+
+	reqBody, err := oasConverter(http.MethodPut, r.paths.Update).ModelToAnyMap(ctx, r.model(&data))
+	if err != nil {
+		tfutils.AddConversionError(&resp.Diagnostics, "Error building request", err)
+		return
+	}
+	if r.beforeUpdate != nil {
+		if err := r.beforeUpdate(ctx, &data, reqBody); err != nil {
+			resp.Diagnostics.AddError("Error building request", err.Error())
+			return
+		}
+	}
+
+	// Update API call logic
+	tflog.Info(ctx, "Update()::API request", map[string]any{
+		"path": r.paths.Update,
+		"body": spew.Sdump(reqBody),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err = r.client.Update(ctx, r.paths.Update, r.pathParams(r.paths.Update, &data), reqBody, &result)
+
+	tflog.Info(ctx, "Update()::API returned", map[string]any{
+		"path":      r.paths.Update,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating resource", err.Error())
+		return
+	}
+
+	if r.reread {
+		// Read the resource again to populate any values not available in the response from Update()
+		if result, err = r.get(ctx, &data); err != nil {
+			resp.Diagnostics.AddError("Error reading resource", err.Error())
+			return
+		}
+	}
+
+	// Merge API response into Terraform model, keeping attributes the API does not return
+	err = r.oasDecoder(http.MethodGet, r.paths.Read).MergeAnyMapToModel(ctx, result, r.model(&data), r.fieldModes)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	if r.afterMerge != nil {
+		r.afterMerge(ctx, &data)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *crudResource[M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data M
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	tflog.Info(ctx, "Delete()::API request", map[string]any{
+		"path": r.paths.Delete,
+		"data": spew.Sdump(data),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.Delete(ctx, r.paths.Delete, r.pathParams(r.paths.Delete, &data), &result)
+
+	tflog.Info(ctx, "Delete()::API returned", map[string]any{
+		"path":      r.paths.Delete,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	// A resource that is already gone is deleted
+	if err != nil && !apiclient.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting resource", err.Error())
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *crudResource[M]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *crudResource[M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	values, err := r.parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+	for attr, value := range values {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+}
+
+// get reads the object of the model.
+func (r *crudResource[M]) get(ctx context.Context, data *M) (map[string]any, error) {
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.Get(ctx, r.paths.Read, r.pathParams(r.paths.Read, data), &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      r.paths.Read,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	return result, err
+}
+
+// model returns the model the API values of data are converted from and to.
+func (r *crudResource[M]) model(data *M) any {
+	if r.target != nil {
+		return r.target(data)
+	}
+	return data
+}
+
+// pathParams returns the parameters of the identity of data that apiPath has, or nil if
+// it has none, such as the path of a create operation whose identity is assigned by the API.
+func (r *crudResource[M]) pathParams(apiPath string, data *M) map[string]string {
+	var params map[string]string
+	for name, value := range r.identity(data) {
+		if strings.Contains(apiPath, "{"+name+"}") {
+			if params == nil {
+				params = map[string]string{}
+			}
+			params[name] = value
+		}
+	}
+	return params
+}
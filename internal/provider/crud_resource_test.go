@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   []string
+		id      string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "namespace and name",
+			attrs: []string{"namespace", "name"},
+			id:    "eda/viewer",
+			want:  map[string]string{"namespace": "eda", "name": "viewer"},
+		},
+		{
+			name:  "single attribute",
+			attrs: []string{"uuid"},
+			id:    "7f0c0a52-5b3e-4f5c-9b9e-0d4c1f1e6a10",
+			want:  map[string]string{"uuid": "7f0c0a52-5b3e-4f5c-9b9e-0d4c1f1e6a10"},
+		},
+		{
+			name:    "missing segment",
+			attrs:   []string{"namespace", "name"},
+			id:      "viewer",
+			wantErr: true,
+		},
+		{
+			name:    "extra segment",
+			attrs:   []string{"namespace", "name"},
+			id:      "eda/viewer/extra",
+			wantErr: true,
+		},
+		{
+			name:    "slash in a single attribute",
+			attrs:   []string{"uuid"},
+			id:      "eda/7f0c0a52",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitImportID(tt.attrs...)(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitImportID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitImportID(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrudResourcePathParams(t *testing.T) {
+	r := &crudResource[crudTestModel]{
+		identity: func(data *crudTestModel) map[string]string {
+			return map[string]string{"namespace": data.Namespace.ValueString(), "name": data.Name.ValueString()}
+		},
+	}
+	data := &crudTestModel{Namespace: types.StringValue("eda"), Name: types.StringValue("viewer")}
+
+	tests := []struct {
+		name    string
+		apiPath string
+		want    map[string]string
+	}{
+		{
+			name:    "all identity parameters",
+			apiPath: "/test/namespaces/{namespace}/things/{name}",
+			want:    map[string]string{"namespace": "eda", "name": "viewer"},
+		},
+		{
+			name:    "name assigned on create",
+			apiPath: "/test/namespaces/{namespace}/things",
+			want:    map[string]string{"namespace": "eda"},
+		},
+		{
+			name:    "no parameters",
+			apiPath: "/test/things",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.pathParams(tt.apiPath, data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pathParams(%q) = %v, want %v", tt.apiPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrudResourceHooks(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	var body map[string]any
+
+	r := newCrudTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("decoding the request body: %v", err)
+		}
+		writeJSON(w, http.StatusOK, `{"namespace": "eda", "name": "viewer", "description": "from the API", "uid": "u1"}`)
+	})
+	r.keepState = func(data, state *crudTestModel) {
+		calls = append(calls, "keepState")
+		data.Uid = state.Uid
+	}
+	r.beforeCreate = func(ctx context.Context, data *crudTestModel, body map[string]any) error {
+		calls = append(calls, "beforeCreate")
+		body["description"] = "edited"
+		return nil
+	}
+	r.beforeUpdate = func(ctx context.Context, data *crudTestModel, body map[string]any) error {
+		calls = append(calls, "beforeUpdate uid="+data.Uid.ValueString())
+		body["description"] = "edited"
+		return nil
+	}
+	r.afterMerge = func(ctx context.Context, data *crudTestModel) {
+		calls = append(calls, "afterMerge description="+data.Description.ValueString())
+	}
+
+	plan := crudTestModel{
+		Namespace:   types.StringValue("eda"),
+		Name:        types.StringValue("viewer"),
+		Description: types.StringValue("planned"),
+		Uid:         types.StringUnknown(),
+	}
+	state := plan
+	state.Uid = types.StringValue("u1")
+
+	t.Run("create", func(t *testing.T) {
+		calls, body = nil, nil
+		resp := &resource.CreateResponse{State: crudTestState(t, nil)}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(crudTestState(t, &plan))}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Create() diagnostics = %v", resp.Diagnostics)
+		}
+		want := []string{"beforeCreate", "afterMerge description=from the API"}
+		if !reflect.DeepEqual(calls, want) {
+			t.Errorf("Create() hooks = %q, want %q", calls, want)
+		}
+		if body["description"] != "edited" {
+			t.Errorf("Create() request description = %v, want the beforeCreate edit", body["description"])
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		calls, body = nil, nil
+		resp := &resource.UpdateResponse{State: crudTestState(t, nil)}
+		r.Update(ctx, resource.UpdateRequest{
+			Plan:  tfsdk.Plan(crudTestState(t, &plan)),
+			State: crudTestState(t, &state),
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Update() diagnostics = %v", resp.Diagnostics)
+		}
+		want := []string{"keepState", "beforeUpdate uid=u1", "afterMerge description=from the API"}
+		if !reflect.DeepEqual(calls, want) {
+			t.Errorf("Update() hooks = %q, want %q", calls, want)
+		}
+		if body["description"] != "edited" || body["uid"] != "u1" {
+			t.Errorf("Update() request body = %v, want the beforeUpdate edit and the kept uid", body)
+		}
+	})
+}
+
+func TestCrudResourceNotFound(t *testing.T) {
+	ctx := context.Background()
+	r := newCrudTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		writeJSON(w, http.StatusNotFound, `{"code": 404, "message": "not found"}`)
+	})
+	state := crudTestModel{
+		Namespace:   types.StringValue("eda"),
+		Name:        types.StringValue("viewer"),
+		Description: types.StringNull(),
+		Uid:         types.StringValue("u1"),
+	}
+
+	t.Run("read removes the resource", func(t *testing.T) {
+		resp := &resource.ReadResponse{State: crudTestState(t, &state)}
+		r.Read(ctx, resource.ReadRequest{State: crudTestState(t, &state)}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+		}
+		if !resp.State.Raw.IsNull() {
+			t.Errorf("Read() state = %v, want the resource removed", resp.State.Raw)
+		}
+	})
+
+	t.Run("delete succeeds", func(t *testing.T) {
+		resp := &resource.DeleteResponse{State: crudTestState(t, &state)}
+		r.Delete(ctx, resource.DeleteRequest{State: crudTestState(t, &state)}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("Delete() diagnostics = %v", resp.Diagnostics)
+		}
+	})
+}
+
+type crudTestModel struct {
+	Namespace   types.String `tfsdk:"namespace"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Uid         types.String `tfsdk:"uid"`
+}
+
+func crudTestSchema(context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace":   schema.StringAttribute{Required: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true, Computed: true},
+			"uid":         schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// newCrudTestResource returns a resource of crudTestModel served by handler.
+func newCrudTestResource(t *testing.T, handler http.HandlerFunc) *crudResource[crudTestModel] {
+	return &crudResource[crudTestModel]{
+		typeName: "_test",
+		schema:   crudTestSchema,
+		paths: crudPaths{
+			Create: "/test/namespaces/{namespace}/things",
+			Read:   "/test/namespaces/{namespace}/things/{name}",
+			Update: "/test/namespaces/{namespace}/things/{name}",
+			Delete: "/test/namespaces/{namespace}/things/{name}",
+		},
+		identity: func(data *crudTestModel) map[string]string {
+			return map[string]string{"namespace": data.Namespace.ValueString(), "name": data.Name.ValueString()}
+		},
+		parseImportID: splitImportID("namespace", "name"),
+		providerData:  newTestProviderData(t, handler),
+	}
+}
+
+// crudTestState returns the state of data, or a null state if data is nil.
+func crudTestState(t *testing.T, data *crudTestModel) tfsdk.State {
+	ctx := context.Background()
+	s := crudTestSchema(ctx)
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if data != nil {
+		if diags := state.Set(ctx, data); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+	}
+	return state
+}
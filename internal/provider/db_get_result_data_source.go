@@ -3,21 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_db_get_result"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
 const read_ds_dbGetResult = "/core/db/v2/data"
 
 var (
-	_ datasource.DataSource              = (*dbGetResultDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*dbGetResultDataSource)(nil)
+	_ datasource.DataSource                   = (*dbGetResultDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*dbGetResultDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*dbGetResultDataSource)(nil)
 )
 
 func NewDbGetResultDataSource() datasource.DataSource {
@@ -25,7 +27,7 @@ func NewDbGetResultDataSource() datasource.DataSource {
 }
 
 type dbGetResultDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *dbGetResultDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,7 +49,7 @@ func (d *dbGetResultDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, read_ds_dbGetResult))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,9 +78,8 @@ func (d *dbGetResultDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	err = d.oasDecoder(http.MethodGet, read_ds_dbGetResult).AnyMapToModel(ctx, result, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -86,6 +87,12 @@ func (d *dbGetResultDataSource) Read(ctx context.Context, req datasource.ReadReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ValidateConfig checks the syntax of the query inputs during plan.
+func (d *dbGetResultDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateQueryAttribute(ctx, d.client, jsPathCheck, req.Config, path.Root("js_path"), &resp.Diagnostics)
+	validateQueryAttribute(ctx, d.client, eqlWhereCheck, req.Config, path.Root("filter"), &resp.Diagnostics)
+}
+
 // Configure adds the provider configured client to the data source.
 func (r *dbGetResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -94,14 +101,14 @@ func (r *dbGetResultDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
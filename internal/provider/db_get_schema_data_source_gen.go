@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_db_get_schema"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewDbGetSchemaDataSource() datasource.DataSource {
 }
 
 type dbGetSchemaDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// dbGetSchemaDataSourceModel is the model of the data source with the extra attribute.
+type dbGetSchemaDataSourceModel struct {
+	datasource_db_get_schema.DbGetSchemaModel
+	tfutils.ExtraModel
 }
 
 func (d *dbGetSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *dbGetSchemaDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *dbGetSchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_db_get_schema.DbGetSchemaDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_db_get_schema.DbGetSchemaDataSourceSchema(ctx))
 }
 
 func (d *dbGetSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_db_get_schema.DbGetSchemaModel
+	var data dbGetSchemaDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *dbGetSchemaDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.DbGetSchemaModel, oasQueryParams(http.MethodGet, read_ds_dbGetSchema))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,7 +84,12 @@ func (d *dbGetSchemaDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_dbGetSchema)
+	err = decoder.AnyMapToModel(ctx, result, &data.DbGetSchemaModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.DbGetSchemaModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -94,14 +107,14 @@ func (r *dbGetSchemaDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -0,0 +1,15 @@
+package provider
+
+import (
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// oasDecoder returns the converter of the response body of the API operation, as
+// oasConverter does, which is lenient unless the provider decodes API responses strictly.
+func (p providerData) oasDecoder(method, path string, listAttr ...string) *tfutils.Converter {
+	c := oasConverter(method, path, listAttr...)
+	if p.strictDecoding {
+		return c
+	}
+	return c.Lenient()
+}
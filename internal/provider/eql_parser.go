@@ -0,0 +1,480 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// eqlSyntaxError is a syntax error in an EQL query or where clause,
+// Pos is the 1-based character position of the offending token.
+type eqlSyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *eqlSyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+// eqlUnknownOperatorError reports the operators of an input that is otherwise valid, but
+// that the local grammar does not know. The input may well be valid for EDA, so it is
+// only known not to be checked.
+type eqlUnknownOperatorError struct {
+	Ops []eqlToken
+}
+
+func (e *eqlUnknownOperatorError) Error() string {
+	ops := make([]string, len(e.Ops))
+	for i, op := range e.Ops {
+		ops[i] = fmt.Sprintf("%s at position %d", op, op.pos)
+	}
+	return "the local EQL grammar does not know the operator " + strings.Join(ops, ", ")
+}
+
+// eqlOperators are the comparison operators of the local grammar, besides in and like,
+// which may be negated with not.
+var eqlOperators = []string{"=", "==", "!=", "<", "<=", ">", ">=", "~="}
+
+type eqlTokenKind int
+
+const (
+	eqlTokEOF eqlTokenKind = iota
+	eqlTokIdent
+	eqlTokString
+	eqlTokNumber
+	eqlTokOp
+	eqlTokPunct
+)
+
+type eqlToken struct {
+	kind eqlTokenKind
+	text string
+	pos  int
+}
+
+func (t eqlToken) String() string {
+	if t.kind == eqlTokEOF {
+		return "end of input"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// eqlTokenize splits an EQL string into tokens, paths such as ".namespace.node.name"
+// and field names such as "oper-state" are single identifier tokens.
+func eqlTokenize(s string) ([]eqlToken, error) {
+	tokens := []eqlToken{}
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(c):
+			i++
+			continue
+		case c == '"' || c == '\'':
+			i++
+			for i < len(runes) && runes[i] != c {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(runes) {
+				return nil, &eqlSyntaxError{Pos: start + 1, Msg: "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, eqlToken{eqlTokString, string(runes[start:i]), start + 1})
+		case c == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]) || unicode.IsDigit(c):
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, eqlToken{eqlTokNumber, string(runes[start:i]), start + 1})
+		case strings.ContainsRune(eqlOperatorRunes, c):
+			for i < len(runes) && strings.ContainsRune(eqlOperatorRunes, runes[i]) {
+				i++
+			}
+			op := string(runes[start:i])
+			if op == "!" {
+				return nil, &eqlSyntaxError{Pos: start + 1, Msg: `unexpected "!"`}
+			}
+			tokens = append(tokens, eqlToken{eqlTokOp, op, start + 1})
+		case strings.ContainsRune("()[]{},", c):
+			i++
+			tokens = append(tokens, eqlToken{eqlTokPunct, string(c), start + 1})
+		case eqlIdentRune(c):
+			for i < len(runes) && eqlIdentRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, eqlToken{eqlTokIdent, string(runes[start:i]), start + 1})
+		default:
+			return nil, &eqlSyntaxError{Pos: start + 1, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(tokens, eqlToken{kind: eqlTokEOF, pos: len(runes) + 1}), nil
+}
+
+// eqlOperatorRunes are the characters of comparison operators, a run of them is one operator.
+const eqlOperatorRunes = "=!<>~"
+
+func eqlIdentRune(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("._-*", c)
+}
+
+// eqlParser is a recursive descent parser checking the syntax of EQL queries
+// and where clauses, it only reports errors and does not build a parse tree.
+type eqlParser struct {
+	tokens []eqlToken
+	next   int
+	// unknown are the operators that were parsed as comparisons without being known.
+	unknown []eqlToken
+}
+
+func (p *eqlParser) peek() eqlToken {
+	return p.tokens[p.next]
+}
+
+func (p *eqlParser) advance() eqlToken {
+	t := p.tokens[p.next]
+	if t.kind != eqlTokEOF {
+		p.next++
+	}
+	return t
+}
+
+// keyword reports whether the next token is one of the keywords, case-insensitive.
+func (p *eqlParser) keyword(kws ...string) bool {
+	t := p.peek()
+	if t.kind != eqlTokIdent {
+		return false
+	}
+	for _, kw := range kws {
+		if strings.EqualFold(t.text, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *eqlParser) punct(c string) bool {
+	t := p.peek()
+	return t.kind == eqlTokPunct && t.text == c
+}
+
+func (p *eqlParser) errorf(t eqlToken, format string, args ...any) error {
+	return &eqlSyntaxError{Pos: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *eqlParser) expectPunct(c string) error {
+	if !p.punct(c) {
+		return p.errorf(p.peek(), "expected %q, got %s", c, p.peek())
+	}
+	p.advance()
+	return nil
+}
+
+func (p *eqlParser) expectKeyword(kw string) error {
+	if !p.keyword(kw) {
+		return p.errorf(p.peek(), "expected %q, got %s", kw, p.peek())
+	}
+	p.advance()
+	return nil
+}
+
+func (p *eqlParser) expectEOF() error {
+	if t := p.peek(); t.kind != eqlTokEOF {
+		return p.errorf(t, "unexpected %s", t)
+	}
+	return nil
+}
+
+// query parses: table [fields [...]] [where (...)] [order by [...]] [limit n]
+// [delta milliseconds n] [sample milliseconds n], each clause at most once.
+func (p *eqlParser) query() error {
+	t := p.peek()
+	if t.kind != eqlTokIdent || !strings.HasPrefix(t.text, ".") {
+		return p.errorf(t, "expected a table path starting with \".\", got %s", t)
+	}
+	if err := p.table(); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for p.peek().kind != eqlTokEOF {
+		t := p.peek()
+		if t.kind != eqlTokIdent {
+			return p.errorf(t, "unexpected %s", t)
+		}
+		clause := strings.ToLower(t.text)
+		if seen[clause] {
+			return p.errorf(t, "duplicate %q clause", clause)
+		}
+		seen[clause] = true
+		p.advance()
+
+		var err error
+		switch clause {
+		case "fields":
+			err = p.list(p.field)
+		case "where":
+			err = p.expr()
+		case "order":
+			if err = p.expectKeyword("by"); err == nil {
+				err = p.list(p.orderField)
+			}
+		case "limit":
+			err = p.number()
+		case "delta", "sample":
+			if err = p.expectKeyword("milliseconds"); err == nil {
+				err = p.number()
+			}
+		default:
+			return p.errorf(t, "unexpected %s, expected one of fields, where, order by, limit, delta or sample", t)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// table parses a table path, with optional key selectors such as ".node{.name=="leaf1"}".
+func (p *eqlParser) table() error {
+	p.advance()
+	for p.punct("{") {
+		open := p.advance()
+		if err := p.expr(); err != nil {
+			return err
+		}
+		if !p.punct("}") {
+			return p.errorf(p.peek(), "expected \"}\" to close %q at position %d, got %s", "{", open.pos, p.peek())
+		}
+		p.advance()
+		if t := p.peek(); t.kind == eqlTokIdent && strings.HasPrefix(t.text, ".") {
+			p.advance()
+		}
+	}
+	return nil
+}
+
+// list parses "[" item ("," item)* "]".
+func (p *eqlParser) list(item func() error) error {
+	if err := p.expectPunct("["); err != nil {
+		return err
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+		if !p.punct(",") {
+			break
+		}
+		p.advance()
+	}
+	return p.expectPunct("]")
+}
+
+// field parses a field path, an aggregate such as count(path), or a string constant.
+func (p *eqlParser) field() error {
+	t := p.peek()
+	switch t.kind {
+	case eqlTokString:
+		p.advance()
+	case eqlTokIdent:
+		p.advance()
+		if p.punct("(") {
+			p.advance()
+			if err := p.field(); err != nil {
+				return err
+			}
+			for p.punct(",") {
+				p.advance()
+				if err := p.field(); err != nil {
+					return err
+				}
+			}
+			if err := p.expectPunct(")"); err != nil {
+				return err
+			}
+		}
+	default:
+		return p.errorf(t, "expected a field, got %s", t)
+	}
+	if p.keyword("as") {
+		p.advance()
+		if t := p.peek(); t.kind != eqlTokIdent && t.kind != eqlTokString {
+			return p.errorf(t, "expected an alias, got %s", t)
+		}
+		p.advance()
+	}
+	return nil
+}
+
+func (p *eqlParser) orderField() error {
+	if err := p.field(); err != nil {
+		return err
+	}
+	if p.keyword("ascending", "descending", "asc", "desc") {
+		p.advance()
+	}
+	return nil
+}
+
+func (p *eqlParser) number() error {
+	if t := p.peek(); t.kind != eqlTokNumber {
+		return p.errorf(t, "expected a number, got %s", t)
+	}
+	p.advance()
+	return nil
+}
+
+// expr parses a where expression: term (("and" | "or") term)*.
+func (p *eqlParser) expr() error {
+	if err := p.term(); err != nil {
+		return err
+	}
+	for p.keyword("and", "or") {
+		p.advance()
+		if err := p.term(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// term parses "not" term, "(" expr ")" or a comparison of a field with a value.
+func (p *eqlParser) term() error {
+	if p.keyword("not") {
+		p.advance()
+		return p.term()
+	}
+	if p.punct("(") {
+		open := p.advance()
+		if err := p.expr(); err != nil {
+			return err
+		}
+		if !p.punct(")") {
+			return p.errorf(p.peek(), "expected \")\" to close %q at position %d, got %s", "(", open.pos, p.peek())
+		}
+		p.advance()
+		return nil
+	}
+
+	t := p.peek()
+	if t.kind != eqlTokIdent || p.keyword("and", "or") {
+		return p.errorf(t, "expected a field, got %s", t)
+	}
+	p.advance()
+
+	op := p.peek()
+	switch {
+	case op.kind == eqlTokOp:
+		p.advance()
+		if !slices.Contains(eqlOperators, op.text) {
+			p.unknown = append(p.unknown, op)
+		}
+	case p.keyword("in"):
+		p.advance()
+		return p.valueList()
+	case p.keyword("like"):
+		p.advance()
+	case p.keyword("not"):
+		p.advance()
+		switch {
+		case p.keyword("in"):
+			p.advance()
+			return p.valueList()
+		case p.keyword("like"):
+			p.advance()
+		case p.peek().kind == eqlTokIdent:
+			// Such as "not contains", an operator the grammar does not know
+			p.unknown = append(p.unknown, p.advance())
+		default:
+			return p.errorf(p.peek(), "expected \"in\" or \"like\", got %s", p.peek())
+		}
+	case op.kind == eqlTokIdent && !p.keyword("and", "or"):
+		// Such as "contains", an operator the grammar does not know
+		p.unknown = append(p.unknown, p.advance())
+	default:
+		return p.errorf(op, "expected an operator after %s, got %s", t, op)
+	}
+	if p.punct("[") {
+		return p.valueList()
+	}
+	return p.value()
+}
+
+func (p *eqlParser) value() error {
+	switch t := p.peek(); t.kind {
+	case eqlTokString, eqlTokNumber:
+		p.advance()
+	case eqlTokIdent:
+		if p.keyword("and", "or", "not") {
+			return p.errorf(t, "expected a value, got %s", t)
+		}
+		p.advance()
+	default:
+		return p.errorf(t, "expected a value, got %s", t)
+	}
+	return nil
+}
+
+func (p *eqlParser) valueList() error {
+	return p.list(p.value)
+}
+
+// parseEql checks the syntax of an EQL query.
+func parseEql(query string) error {
+	return eqlParse(query, (*eqlParser).query)
+}
+
+// parseEqlWhere checks the syntax of an EQL where clause.
+func parseEqlWhere(where string) error {
+	return eqlParse(where, (*eqlParser).expr)
+}
+
+// eqlParse checks the syntax of input with the given rule of the parser. Valid inputs
+// with operators the grammar does not know return an *eqlUnknownOperatorError.
+func eqlParse(input string, rule func(p *eqlParser) error) error {
+	tokens, err := eqlTokenize(input)
+	if err != nil {
+		return err
+	}
+	p := &eqlParser{tokens: tokens}
+	if err := rule(p); err != nil {
+		return err
+	}
+	if err := p.expectEOF(); err != nil {
+		return err
+	}
+	if len(p.unknown) > 0 {
+		return &eqlUnknownOperatorError{Ops: p.unknown}
+	}
+	return nil
+}
+
+// eqlQuote returns value as a double quoted EQL string literal.
+func eqlQuote(value string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, c := range value {
+		if c == '"' || c == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// parseJsPath checks the syntax of a JS path, a table path with optional key selectors.
+func parseJsPath(jsPath string) error {
+	return eqlParse(jsPath, func(p *eqlParser) error {
+		if t := p.peek(); t.kind != eqlTokIdent || !strings.HasPrefix(t.text, ".") {
+			return p.errorf(t, "expected a path starting with \".\", got %s", t)
+		}
+		return p.table()
+	})
+}
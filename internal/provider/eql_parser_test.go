@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseEql(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantPos int
+		wantMsg string
+	}{
+		{
+			name:  "table only",
+			input: ".namespace.node.srl.interface",
+		},
+		{
+			name:  "fields and where",
+			input: `.namespace.node.srl.interface fields [admin-state, oper-state] where (oper-state = "down")`,
+		},
+		{
+			name:  "key selector",
+			input: `.namespace.node{.name=="leaf1"}.srl.interface where (.namespace.name = "eda")`,
+		},
+		{
+			name:  "all clauses",
+			input: `.namespace.node fields [name, count(name) as total] where (name != "spine1" and not (version < 24)) order by [name descending] limit 10 delta milliseconds 1000 sample milliseconds 500`,
+		},
+		{
+			name:  "in and not in",
+			input: `.namespace.node where (name in ["leaf1", "leaf2"] or name not in ["spine1"])`,
+		},
+		{
+			name:  "regex match",
+			input: `.namespace.node where (version ~= "24.*")`,
+		},
+		{
+			name:  "like and not like",
+			input: `.namespace.node.srl.interface where (description like "uplink%" and description not like "%lab%")`,
+		},
+		{
+			name:  "case-insensitive keywords",
+			input: `.namespace.node WHERE (name = "leaf1") LIMIT 1`,
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantPos: 1,
+			wantMsg: `expected a table path starting with ".", got end of input`,
+		},
+		{
+			name:    "table without dot",
+			input:   "namespace.node",
+			wantPos: 1,
+			wantMsg: `expected a table path starting with ".", got "namespace.node"`,
+		},
+		{
+			name:    "unterminated string",
+			input:   `.namespace.node where (name = "leaf1)`,
+			wantPos: 31,
+			wantMsg: "unterminated string",
+		},
+		{
+			name:    "unclosed parenthesis",
+			input:   `.namespace.node where (name = "leaf1"`,
+			wantPos: 38,
+			wantMsg: `expected ")" to close "(" at position 23, got end of input`,
+		},
+		{
+			name:    "missing value",
+			input:   `.namespace.node where (name = )`,
+			wantPos: 31,
+			wantMsg: `expected a value, got ")"`,
+		},
+		{
+			name:    "missing operator",
+			input:   `.namespace.node where (name "leaf1")`,
+			wantPos: 29,
+			wantMsg: `expected an operator after "name", got "\"leaf1\""`,
+		},
+		{
+			name:    "unknown clause",
+			input:   `.namespace.node having (name = "leaf1")`,
+			wantPos: 17,
+			wantMsg: `unexpected "having", expected one of fields, where, order by, limit, delta or sample`,
+		},
+		{
+			name:    "duplicate clause",
+			input:   `.namespace.node limit 1 limit 2`,
+			wantPos: 25,
+			wantMsg: `duplicate "limit" clause`,
+		},
+		{
+			name:    "limit without number",
+			input:   `.namespace.node limit all`,
+			wantPos: 23,
+			wantMsg: `expected a number, got "all"`,
+		},
+		{
+			name:    "unclosed fields",
+			input:   `.namespace.node fields [name, version`,
+			wantPos: 38,
+			wantMsg: `expected "]", got end of input`,
+		},
+		{
+			name:    "lone exclamation mark",
+			input:   `.namespace.node where (! name = "leaf1")`,
+			wantPos: 24,
+			wantMsg: `unexpected "!"`,
+		},
+		{
+			name:    "unexpected character",
+			input:   `.namespace.node where (name = "leaf1") ;`,
+			wantPos: 40,
+			wantMsg: `unexpected character ';'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseEql(tt.input)
+			checkEqlError(t, err, tt.wantPos, tt.wantMsg)
+		})
+	}
+}
+
+func TestParseEqlWhere(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantPos int
+		wantMsg string
+	}{
+		{
+			name:  "comparison",
+			input: `oper-state = "down"`,
+		},
+		{
+			name:  "nested",
+			input: `(admin-state = "enable" and (oper-state != "up" or not mtu >= 9000))`,
+		},
+		{
+			name:  "numbers and identifiers",
+			input: `mtu > -1 and speed <= 100.5 and enabled = true`,
+		},
+		{
+			name:    "trailing operator",
+			input:   `name = "leaf1" and`,
+			wantPos: 19,
+			wantMsg: "expected a field, got end of input",
+		},
+		{
+			name:    "keyword as field",
+			input:   `and = "leaf1"`,
+			wantPos: 1,
+			wantMsg: `expected a field, got "and"`,
+		},
+		{
+			name:    "trailing token",
+			input:   `name = "leaf1" "leaf2"`,
+			wantPos: 16,
+			wantMsg: `unexpected "\"leaf2\""`,
+		},
+		{
+			name:    "not without in or like",
+			input:   `name not = "leaf1"`,
+			wantPos: 10,
+			wantMsg: `expected "in" or "like", got "="`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseEqlWhere(tt.input)
+			checkEqlError(t, err, tt.wantPos, tt.wantMsg)
+		})
+	}
+}
+
+func TestParseEqlUnknownOperator(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantOps []string
+	}{
+		{
+			name:    "symbolic operator",
+			input:   `name !~ "leaf.*"`,
+			wantOps: []string{"!~"},
+		},
+		{
+			name:    "word operator",
+			input:   `description contains "uplink"`,
+			wantOps: []string{"contains"},
+		},
+		{
+			name:    "negated word operator",
+			input:   `description not contains "uplink" and name <> "leaf1"`,
+			wantOps: []string{"contains", "<>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseEqlWhere(tt.input)
+			var unknownErr *eqlUnknownOperatorError
+			if !errors.As(err, &unknownErr) {
+				t.Fatalf("parseEqlWhere(%q) error = %v, want an unknown operator error", tt.input, err)
+			}
+			ops := []string{}
+			for _, op := range unknownErr.Ops {
+				ops = append(ops, op.text)
+			}
+			if len(ops) != len(tt.wantOps) {
+				t.Fatalf("parseEqlWhere(%q) unknown operators = %v, want %v", tt.input, ops, tt.wantOps)
+			}
+			for i := range ops {
+				if ops[i] != tt.wantOps[i] {
+					t.Errorf("parseEqlWhere(%q) unknown operators = %v, want %v", tt.input, ops, tt.wantOps)
+				}
+			}
+		})
+	}
+
+	// A syntax error is still reported with an unknown operator
+	err := parseEqlWhere(`description contains`)
+	checkEqlError(t, err, 21, "expected a value, got end of input")
+}
+
+func TestParseJsPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantPos int
+		wantMsg string
+	}{
+		{
+			name:  "path",
+			input: ".namespace.node.srl.interface",
+		},
+		{
+			name:  "key selectors",
+			input: `.namespace{.name=="eda"}.node{.name=="leaf1"}.srl`,
+		},
+		{
+			name:    "relative path",
+			input:   "node.srl",
+			wantPos: 1,
+			wantMsg: `expected a path starting with ".", got "node.srl"`,
+		},
+		{
+			name:    "unclosed key selector",
+			input:   `.node{.name=="leaf1"`,
+			wantPos: 21,
+			wantMsg: `expected "}" to close "{" at position 6, got end of input`,
+		},
+		{
+			name:    "query clause",
+			input:   `.node where (name = "leaf1")`,
+			wantPos: 7,
+			wantMsg: `unexpected "where"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseJsPath(tt.input)
+			checkEqlError(t, err, tt.wantPos, tt.wantMsg)
+		})
+	}
+}
+
+func TestEqlQuote(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain",
+			input:    "leaf1",
+			expected: `"leaf1"`,
+		},
+		{
+			name:     "quotes and backslashes",
+			input:    `say "hi" \ bye`,
+			expected: `"say \"hi\" \\ bye"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := eqlQuote(tt.input)
+			if result != tt.expected {
+				t.Errorf("eqlQuote(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+			if err := parseEqlWhere("name = " + result); err != nil {
+				t.Errorf("parseEqlWhere() of the quoted value error = %v", err)
+			}
+		})
+	}
+}
+
+// checkEqlError checks that err is nil if wantMsg is empty, or a syntax error at wantPos.
+func checkEqlError(t *testing.T, err error, wantPos int, wantMsg string) {
+	t.Helper()
+	if wantMsg == "" {
+		if err != nil {
+			t.Errorf("error = %v, want nil", err)
+		}
+		return
+	}
+	var syntaxErr *eqlSyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("error = %v, want a syntax error", err)
+	}
+	if syntaxErr.Pos != wantPos || syntaxErr.Msg != wantMsg {
+		t.Errorf("error = %q at position %d, want %q at position %d", syntaxErr.Msg, syntaxErr.Pos, wantMsg, wantPos)
+	}
+}
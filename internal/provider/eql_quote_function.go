@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*eqlQuoteFunction)(nil)
+
+func NewEqlQuoteFunction() function.Function {
+	return &eqlQuoteFunction{}
+}
+
+// eqlQuoteFunction turns a string into an EQL string literal.
+type eqlQuoteFunction struct{}
+
+func (f *eqlQuoteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "eql_quote"
+}
+
+func (f *eqlQuoteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Quotes a string as an EQL literal",
+		Description:         "Returns the value as a double quoted EQL string literal, with double quotes and backslashes escaped, for use in EQL queries and where clauses.",
+		MarkdownDescription: "Returns the value as a double quoted EQL string literal, with double quotes and backslashes escaped, for use in EQL queries and where clauses.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				Description:         "The value to quote.",
+				MarkdownDescription: "The value to quote.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *eqlQuoteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, eqlQuote(value)))
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*eqlValidateFunction)(nil)
+
+func NewEqlValidateFunction() function.Function {
+	return &eqlValidateFunction{
+		name:  "eql_validate",
+		what:  "EQL query",
+		parse: parseEql,
+	}
+}
+
+func NewEqlValidateWhereFunction() function.Function {
+	return &eqlValidateFunction{
+		name:  "eql_validate_where",
+		what:  "EQL where clause",
+		parse: parseEqlWhere,
+	}
+}
+
+// eqlValidateFunction checks the syntax of an EQL query or where clause and returns it unchanged.
+// Provider functions cannot reach the API, the check uses the local EQL grammar.
+type eqlValidateFunction struct {
+	name  string
+	what  string
+	parse func(string) error
+}
+
+func (f *eqlValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *eqlValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Checks the syntax of an " + f.what,
+		Description:         "Returns the input unchanged if it is syntactically valid as an " + f.what + ", and fails with the position of the first error otherwise. The check is done locally, the tables and fields are not checked against the EDA schema, and comparisons with operators the local grammar does not know are not checked.",
+		MarkdownDescription: "Returns the input unchanged if it is syntactically valid as an " + f.what + ", and fails with the position of the first error otherwise. The check is done locally, the tables and fields are not checked against the EDA schema, and comparisons with operators the local grammar does not know are not checked.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "input",
+				Description:         "The " + f.what + " to check.",
+				MarkdownDescription: "The " + f.what + " to check.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *eqlValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+
+	if resp.Error != nil {
+		return
+	}
+
+	// An input with operators the local grammar does not know cannot be checked,
+	// it is returned unchanged rather than rejected.
+	var unknownErr *eqlUnknownOperatorError
+	if err := f.parse(input); err != nil && !errors.As(err, &unknownErr) {
+		resp.Error = function.NewArgumentFuncError(0, "invalid "+f.what+": "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, input))
+}
@@ -2,18 +2,31 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_health"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
-const read_ds_health = "/core/about/health"
+const (
+	read_ds_health = "/core/about/health"
+
+	DEF_HEALTH_WAIT_TIMEOUT = 10 * time.Minute
+)
+
+// healthPollInterval is the time between two reads of the health report while waiting
+// for EDA to become healthy. It is a variable so that tests do not wait as long.
+var healthPollInterval = 10 * time.Second
 
 var (
 	_ datasource.DataSource              = (*healthDataSource)(nil)
@@ -25,7 +38,7 @@ func NewHealthDataSource() datasource.DataSource {
 }
 
 type healthDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *healthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +46,11 @@ func (d *healthDataSource) Metadata(ctx context.Context, req datasource.Metadata
 }
 
 func (d *healthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_health.HealthDataSourceSchema(ctx)
+	resp.Schema = datasource_health.HealthCustomDataSourceSchema(ctx)
 }
 
 func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_health.HealthModel
+	var data datasource_health.HealthCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -46,23 +59,77 @@ func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error extracting query params", err.Error())
+	wait := data.WaitUntilHealthy.ValueBool()
+	waitTimeout := DEF_HEALTH_WAIT_TIMEOUT
+	if !data.WaitTimeout.IsNull() {
+		var err error
+		waitTimeout, err = time.ParseDuration(data.WaitTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid wait timeout", err.Error())
+			return
+		}
+	}
+
+	deadline := time.Now().Add(waitTimeout)
+	var result map[string]any
+	for {
+		var err error
+		result, err = d.readHealth(ctx)
+
+		if err != nil && !wait {
+			resp.Diagnostics.AddError("Error reading resource", err.Error())
+			return
+		}
+		if err == nil && (!wait || healthReportHealthy(result)) {
+			break
+		}
+
+		// A freshly installed EDA may not even answer yet, so keep polling on errors
+		lastState := fmt.Sprintf("status %v", result["status"])
+		if err != nil {
+			lastState = err.Error()
+		}
+		if time.Now().Add(healthPollInterval).After(deadline) {
+			resp.Diagnostics.AddError("Timed out waiting for EDA to become healthy",
+				fmt.Sprintf("EDA did not become healthy within %s, last reported: %s", waitTimeout, lastState))
+			return
+		}
+
+		tflog.Info(ctx, "Read()::Waiting for EDA to become healthy", map[string]any{
+			"last":     lastState,
+			"deadline": deadline.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Error waiting for EDA to become healthy", ctx.Err().Error())
+			return
+		case <-time.After(healthPollInterval):
+		}
+	}
+
+	// Convert API response to Terraform model
+	err := d.oasDecoder(http.MethodGet, read_ds_health).AnyMapToModel(ctx, result, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
+	data.Healthy = types.BoolValue(healthReportHealthy(result))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
+// readHealth fetches the health report. EDA answers with an error status when the
+// cluster is degraded or down, the report in the body is returned in that case too.
+func (d *healthDataSource) readHealth(ctx context.Context) (map[string]any, error) {
 	// Read API call logic
 	tflog.Info(ctx, "Read()::API request", map[string]any{
-		"path":  read_ds_health,
-		"data":  spew.Sdump(data),
-		"query": queryParams,
+		"path": read_ds_health,
 	})
 
 	t0 := time.Now()
 	result := map[string]any{}
-	err = d.client.GetByQuery(ctx, read_ds_health, nil, queryParams, &result)
+	err := d.client.Get(ctx, read_ds_health, nil, &result)
 
 	tflog.Info(ctx, "Read()::API returned", map[string]any{
 		"path":      read_ds_health,
@@ -70,20 +137,30 @@ func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		"timeTaken": time.Since(t0).String(),
 	})
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading resource", err.Error())
-		return
+	var apiErr *apiclient.ApiError
+	if errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusInternalServerError || apiErr.StatusCode == http.StatusServiceUnavailable) {
+		report := map[string]any{}
+		if json.Unmarshal([]byte(apiErr.Body), &report) == nil && report["status"] != nil {
+			return report, nil
+		}
 	}
+	return result, err
+}
 
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
-		return
+// healthReportHealthy reports whether the overall status and all service statuses are UP.
+func healthReportHealthy(report map[string]any) bool {
+	if report["status"] != "UP" {
+		return false
 	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	services, _ := report["services"].(map[string]any)
+	for _, svc := range services {
+		svcMap, _ := svc.(map[string]any)
+		if svcMap["status"] != "UP" {
+			return false
+		}
+	}
+	return true
 }
 
 // Configure adds the provider configured client to the data source.
@@ -94,14 +171,14 @@ func (r *healthDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_health"
+)
+
+const (
+	healthTestUp       = `{"status": "UP", "mode": "active", "timestamp": "2026-10-19T08:00:00Z", "services": {"api-server": {"status": "UP"}}}`
+	healthTestDown     = `{"status": "DOWN", "mode": "active", "timestamp": "2026-10-19T08:00:00Z", "services": {"api-server": {"status": "DOWN", "error": "not ready"}}}`
+	healthTestDegraded = `{"status": "UP", "mode": "active", "timestamp": "2026-10-19T08:00:00Z", "services": {"api-server": {"status": "DOWN", "error": "not ready"}}}`
+)
+
+func TestHealthReportHealthy(t *testing.T) {
+	tests := []struct {
+		name   string
+		report map[string]any
+		want   bool
+	}{
+		{
+			name:   "all up",
+			report: map[string]any{"status": "UP", "services": map[string]any{"api-server": map[string]any{"status": "UP"}}},
+			want:   true,
+		},
+		{
+			name:   "no services",
+			report: map[string]any{"status": "UP"},
+			want:   true,
+		},
+		{
+			name:   "down",
+			report: map[string]any{"status": "DOWN", "services": map[string]any{"api-server": map[string]any{"status": "UP"}}},
+		},
+		{
+			name:   "service down",
+			report: map[string]any{"status": "UP", "services": map[string]any{"api-server": map[string]any{"status": "DOWN"}}},
+		},
+		{
+			name:   "service without a status",
+			report: map[string]any{"status": "UP", "services": map[string]any{"api-server": "UP"}},
+		},
+		{
+			name:   "empty report",
+			report: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := healthReportHealthy(tt.report); got != tt.want {
+				t.Errorf("healthReportHealthy(%v) = %v, want %v", tt.report, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthRead(t *testing.T) {
+	interval := healthPollInterval
+	healthPollInterval = time.Millisecond
+	t.Cleanup(func() { healthPollInterval = interval })
+
+	type response struct {
+		status int
+		body   string
+	}
+
+	tests := []struct {
+		name    string
+		wait    bool
+		timeout string
+		// responses are answered in order, the last one repeatedly
+		responses    []response
+		wantHealthy  bool
+		wantStatus   string
+		wantRequests int
+		wantErr      string
+	}{
+		{
+			name:         "healthy",
+			responses:    []response{{http.StatusOK, healthTestUp}},
+			wantHealthy:  true,
+			wantStatus:   "UP",
+			wantRequests: 1,
+		},
+		{
+			name:         "report in a 503 body",
+			responses:    []response{{http.StatusServiceUnavailable, healthTestDown}},
+			wantStatus:   "DOWN",
+			wantRequests: 1,
+		},
+		{
+			name:         "report in a 500 body",
+			responses:    []response{{http.StatusInternalServerError, healthTestDegraded}},
+			wantStatus:   "UP",
+			wantRequests: 1,
+		},
+		{
+			name:         "500 without a report",
+			responses:    []response{{http.StatusInternalServerError, `{"code": 500, "message": "internal error"}`}},
+			wantRequests: 1,
+			wantErr:      "Error reading resource",
+		},
+		{
+			name: "wait until healthy",
+			wait: true,
+			responses: []response{
+				{http.StatusBadGateway, `upstream not ready`},
+				{http.StatusServiceUnavailable, healthTestDown},
+				{http.StatusOK, healthTestDegraded},
+				{http.StatusOK, healthTestUp},
+			},
+			wantHealthy:  true,
+			wantStatus:   "UP",
+			wantRequests: 4,
+		},
+		{
+			name:      "wait timeout",
+			wait:      true,
+			timeout:   "50ms",
+			responses: []response{{http.StatusServiceUnavailable, healthTestDown}},
+			wantErr:   "Timed out waiting for EDA to become healthy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			requests := 0
+			d := &healthDataSource{providerData: newTestProviderData(t, func(w http.ResponseWriter, req *http.Request) {
+				resp := tt.responses[min(requests, len(tt.responses)-1)]
+				requests++
+				writeJSON(w, resp.status, resp.body)
+			})}
+
+			s := datasource_health.HealthCustomDataSourceSchema(ctx)
+			configType := s.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attrType := range configType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["wait_until_healthy"] = tftypes.NewValue(tftypes.Bool, tt.wait)
+			if tt.timeout != "" {
+				values["wait_timeout"] = tftypes.NewValue(tftypes.String, tt.timeout)
+			}
+
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(configType, nil)}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: s, Raw: tftypes.NewValue(configType, values)}}, resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Summary(), tt.wantErr) {
+					t.Fatalf("Read() diagnostics = %v, want %q", resp.Diagnostics, tt.wantErr)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("Read() made %d requests, want %d", requests, tt.wantRequests)
+			}
+			var data datasource_health.HealthCustomModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if data.Healthy.ValueBool() != tt.wantHealthy || data.Status.ValueString() != tt.wantStatus {
+				t.Errorf("Read() healthy = %s, status = %s, want %v and %s", data.Healthy, data.Status, tt.wantHealthy, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+//go:generate go run ../gen/jsonnames -oas ../../specs/oas.json -out json_names_gen.go
+
+var (
+	oasNamingRules = tfutils.DefaultNamingRules()
+	oasConverters  sync.Map
+)
+
+// oasConverter returns the converter of the request and response bodies of the API operation
+// with the given method and path, which uses the API names of the specification. When the
+// response is an array that is set to the attribute listAttr of the model, the names of the
+// items are looked up under that attribute.
+func oasConverter(method, path string, listAttr ...string) *tfutils.Converter {
+	key := method + " " + path
+	if len(listAttr) > 0 {
+		key += " " + listAttr[0]
+	}
+	if c, ok := oasConverters.Load(key); ok {
+		return c.(*tfutils.Converter)
+	}
+
+	names := oasLookup(oasJSONNameTable, method, path)
+	if len(listAttr) > 0 {
+		prefix := tfutils.NameKey(listAttr[0])
+		listNames := make(map[string]string, len(names))
+		for k, v := range names {
+			listNames[prefix+"."+k] = v
+		}
+		names = listNames
+	}
+	rules := oasNamingRules
+	rules.JSONNames = names
+	c, _ := oasConverters.LoadOrStore(key, tfutils.NewConverter(rules))
+	return c.(*tfutils.Converter)
+}
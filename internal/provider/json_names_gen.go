@@ -0,0 +1,2985 @@
+// Code generated by jsonnames from specs/oas.json. DO NOT EDIT.
+
+package provider
+
+// oasJSONNameTable holds the API names of the attributes of the request and response
+// bodies of the API operations, by method and path, see tfutils.NamingRules.
+var oasJSONNameTable = map[string]map[string]string{
+	"GET /core/about/health": {
+		"mode":            "mode",
+		"services":        "services",
+		"services.error":  "error",
+		"services.status": "status",
+		"status":          "status",
+		"timestamp":       "timestamp",
+	},
+	"GET /core/about/version": {
+		"builtdate": "builtDate",
+		"version":   "version",
+	},
+	"POST /core/access/v1/checkaccess": {
+		"access":                       "access",
+		"error":                        "error",
+		"error.causecollection":        "causeCollection",
+		"error.causeindexedcollection": "causeIndexedCollection",
+		"error.causeisinternal":        "causeIsInternal",
+		"error.causesimple":            "causeSimple",
+		"error.causewrapped":           "causeWrapped",
+		"error.domain":                 "domain",
+		"error.index":                  "index",
+		"error.message":                "message",
+		"error.ref":                    "ref",
+		"error.type":                   "type",
+		"error.values":                 "values",
+		"gvk":                          "gvk",
+		"gvk.group":                    "group",
+		"gvk.kind":                     "kind",
+		"gvk.version":                  "version",
+		"gvr":                          "gvr",
+		"gvr.group":                    "group",
+		"gvr.resource":                 "resource",
+		"gvr.version":                  "version",
+		"namespace":                    "namespace",
+		"path":                         "path",
+		"type":                         "type",
+	},
+	"GET /core/access/v1/namespaces": {
+		"allnamesapces":          "allNamesapces",
+		"allnamespaces":          "allNamespaces",
+		"namespaces":             "namespaces",
+		"namespaces.description": "description",
+		"namespaces.name":        "name",
+	},
+	"GET /core/admin/federationproviders": {
+		"auth":                                 "auth",
+		"auth.bindcredential":                  "bindCredential",
+		"auth.binddn":                          "bindDN",
+		"enabled":                              "enabled",
+		"groupsupport":                         "groupSupport",
+		"groupsupport.filter":                  "filter",
+		"groupsupport.groupldapdn":             "groupLDAPDN",
+		"groupsupport.memberattribute":         "memberAttribute",
+		"groupsupport.memberofattribute":       "memberOfAttribute",
+		"groupsupport.membershipattributetype": "membershipAttributeType",
+		"groupsupport.membershipuserattribute": "membershipUserAttribute",
+		"groupsupport.nameldapattribute":       "NameLDAPAttribute",
+		"groupsupport.objectclasses":           "objectClasses",
+		"groupsupport.retrievalstrategy":       "retrievalStrategy",
+		"idattribute":                          "idAttribute",
+		"import":                               "import",
+		"pagination":                           "pagination",
+		"periodicsync":                         "periodicSync",
+		"periodicsyncsecs":                     "periodicSyncSecs",
+		"rdnldapattribute":                     "rdnLDAPAttribute",
+		"readonly":                             "readOnly",
+		"scope":                                "scope",
+		"timeout":                              "timeout",
+		"tls":                                  "tls",
+		"type":                                 "type",
+		"url":                                  "url",
+		"userdn":                               "userDN",
+		"usernameattribute":                    "usernameAttribute",
+		"userobjectclasses":                    "userObjectClasses",
+		"usersearchfilter":                     "userSearchFilter",
+		"uuid":                                 "uuid",
+		"vendor":                               "vendor",
+	},
+	"POST /core/admin/federationproviders": {
+		"auth":                                 "auth",
+		"auth.bindcredential":                  "bindCredential",
+		"auth.binddn":                          "bindDN",
+		"enabled":                              "enabled",
+		"groupsupport":                         "groupSupport",
+		"groupsupport.filter":                  "filter",
+		"groupsupport.groupldapdn":             "groupLDAPDN",
+		"groupsupport.memberattribute":         "memberAttribute",
+		"groupsupport.memberofattribute":       "memberOfAttribute",
+		"groupsupport.membershipattributetype": "membershipAttributeType",
+		"groupsupport.membershipuserattribute": "membershipUserAttribute",
+		"groupsupport.nameldapattribute":       "NameLDAPAttribute",
+		"groupsupport.objectclasses":           "objectClasses",
+		"groupsupport.retrievalstrategy":       "retrievalStrategy",
+		"idattribute":                          "idAttribute",
+		"import":                               "import",
+		"name":                                 "name",
+		"pagination":                           "pagination",
+		"periodicsync":                         "periodicSync",
+		"periodicsyncsecs":                     "periodicSyncSecs",
+		"rdnldapattribute":                     "rdnLDAPAttribute",
+		"readonly":                             "readOnly",
+		"scope":                                "scope",
+		"timeout":                              "timeout",
+		"tls":                                  "tls",
+		"type":                                 "type",
+		"url":                                  "url",
+		"userdn":                               "userDN",
+		"usernameattribute":                    "usernameAttribute",
+		"userobjectclasses":                    "userObjectClasses",
+		"usersearchfilter":                     "userSearchFilter",
+		"uuid":                                 "uuid",
+		"vendor":                               "vendor",
+	},
+	"GET /core/admin/federationproviders/{uuid}": {
+		"auth":                                 "auth",
+		"auth.bindcredential":                  "bindCredential",
+		"auth.binddn":                          "bindDN",
+		"enabled":                              "enabled",
+		"groupsupport":                         "groupSupport",
+		"groupsupport.filter":                  "filter",
+		"groupsupport.groupldapdn":             "groupLDAPDN",
+		"groupsupport.memberattribute":         "memberAttribute",
+		"groupsupport.memberofattribute":       "memberOfAttribute",
+		"groupsupport.membershipattributetype": "membershipAttributeType",
+		"groupsupport.membershipuserattribute": "membershipUserAttribute",
+		"groupsupport.nameldapattribute":       "NameLDAPAttribute",
+		"groupsupport.objectclasses":           "objectClasses",
+		"groupsupport.retrievalstrategy":       "retrievalStrategy",
+		"idattribute":                          "idAttribute",
+		"import":                               "import",
+		"name":                                 "name",
+		"pagination":                           "pagination",
+		"periodicsync":                         "periodicSync",
+		"periodicsyncsecs":                     "periodicSyncSecs",
+		"rdnldapattribute":                     "rdnLDAPAttribute",
+		"readonly":                             "readOnly",
+		"scope":                                "scope",
+		"timeout":                              "timeout",
+		"tls":                                  "tls",
+		"type":                                 "type",
+		"url":                                  "url",
+		"userdn":                               "userDN",
+		"usernameattribute":                    "usernameAttribute",
+		"userobjectclasses":                    "userObjectClasses",
+		"usersearchfilter":                     "userSearchFilter",
+		"vendor":                               "vendor",
+	},
+	"PUT /core/admin/federationproviders/{uuid}": {
+		"auth":                                 "auth",
+		"auth.bindcredential":                  "bindCredential",
+		"auth.binddn":                          "bindDN",
+		"enabled":                              "enabled",
+		"groupsupport":                         "groupSupport",
+		"groupsupport.filter":                  "filter",
+		"groupsupport.groupldapdn":             "groupLDAPDN",
+		"groupsupport.memberattribute":         "memberAttribute",
+		"groupsupport.memberofattribute":       "memberOfAttribute",
+		"groupsupport.membershipattributetype": "membershipAttributeType",
+		"groupsupport.membershipuserattribute": "membershipUserAttribute",
+		"groupsupport.nameldapattribute":       "NameLDAPAttribute",
+		"groupsupport.objectclasses":           "objectClasses",
+		"groupsupport.retrievalstrategy":       "retrievalStrategy",
+		"idattribute":                          "idAttribute",
+		"import":                               "import",
+		"name":                                 "name",
+		"pagination":                           "pagination",
+		"periodicsync":                         "periodicSync",
+		"periodicsyncsecs":                     "periodicSyncSecs",
+		"rdnldapattribute":                     "rdnLDAPAttribute",
+		"readonly":                             "readOnly",
+		"scope":                                "scope",
+		"timeout":                              "timeout",
+		"tls":                                  "tls",
+		"type":                                 "type",
+		"url":                                  "url",
+		"userdn":                               "userDN",
+		"usernameattribute":                    "usernameAttribute",
+		"userobjectclasses":                    "userObjectClasses",
+		"usersearchfilter":                     "userSearchFilter",
+		"vendor":                               "vendor",
+	},
+	"POST /core/admin/federationprovidertest": {
+		"action":              "action",
+		"auth":                "auth",
+		"auth.bindcredential": "bindCredential",
+		"auth.binddn":         "bindDN",
+		"name":                "name",
+		"timeout":             "timeout",
+		"tls":                 "tls",
+		"url":                 "url",
+	},
+	"GET /core/admin/groups": {
+		"description": "description",
+		"isfederated": "isFederated",
+		"name":        "name",
+		"roles":       "roles",
+		"users":       "users",
+		"uuid":        "uuid",
+	},
+	"POST /core/admin/groups": {
+		"description":                                      "description",
+		"fullroles":                                        "fullRoles",
+		"fullroles.description":                            "description",
+		"fullroles.name":                                   "name",
+		"fullroles.namespace":                              "namespace",
+		"fullroles.resourcerules":                          "resourceRules",
+		"fullroles.resourcerules.apigroups":                "apiGroups",
+		"fullroles.resourcerules.permissions":              "permissions",
+		"fullroles.resourcerules.resources":                "resources",
+		"fullroles.tablerules":                             "tableRules",
+		"fullroles.tablerules.path":                        "path",
+		"fullroles.tablerules.permissions":                 "permissions",
+		"fullroles.urlrules":                               "urlRules",
+		"fullroles.urlrules.path":                          "path",
+		"fullroles.urlrules.permissions":                   "permissions",
+		"fullusers.email":                                  "email",
+		"fullusers.enabled":                                "enabled",
+		"fullusers.firstname":                              "firstName",
+		"fullusers.groups":                                 "groups",
+		"fullusers.lastname":                               "lastName",
+		"fullusers.maxsessions":                            "maxSessions",
+		"fullusers.password":                               "password",
+		"fullusers.status":                                 "status",
+		"fullusers.status.failedloginsincesuccessfullogin": "failedLoginSinceSuccessfulLogin",
+		"fullusers.status.federationprovidername":          "federationProviderName",
+		"fullusers.status.federationprovideruuid":          "federationProviderUUID",
+		"fullusers.status.isfederateduser":                 "isFederatedUser",
+		"fullusers.status.lastfailedlogin":                 "lastFailedLogin",
+		"fullusers.status.lastsuccessfullogin":             "lastSuccessfulLogin",
+		"fullusers.status.temporarilydisabled":             "temporarilyDisabled",
+		"fullusers.username":                               "username",
+		"fullusers.uuid":                                   "uuid",
+		"isfederated":                                      "isFederated",
+		"name":                                             "name",
+		"roles":                                            "roles",
+		"users":                                            "users",
+		"uuid":                                             "uuid",
+	},
+	"GET /core/admin/groups/{uuid}": {
+		"description": "description",
+		"isfederated": "isFederated",
+		"name":        "name",
+		"roles":       "roles",
+		"users":       "users",
+	},
+	"PUT /core/admin/groups/{uuid}": {
+		"description":                                      "description",
+		"fullroles":                                        "fullRoles",
+		"fullroles.description":                            "description",
+		"fullroles.name":                                   "name",
+		"fullroles.namespace":                              "namespace",
+		"fullroles.resourcerules":                          "resourceRules",
+		"fullroles.resourcerules.apigroups":                "apiGroups",
+		"fullroles.resourcerules.permissions":              "permissions",
+		"fullroles.resourcerules.resources":                "resources",
+		"fullroles.tablerules":                             "tableRules",
+		"fullroles.tablerules.path":                        "path",
+		"fullroles.tablerules.permissions":                 "permissions",
+		"fullroles.urlrules":                               "urlRules",
+		"fullroles.urlrules.path":                          "path",
+		"fullroles.urlrules.permissions":                   "permissions",
+		"fullusers.email":                                  "email",
+		"fullusers.enabled":                                "enabled",
+		"fullusers.firstname":                              "firstName",
+		"fullusers.groups":                                 "groups",
+		"fullusers.lastname":                               "lastName",
+		"fullusers.maxsessions":                            "maxSessions",
+		"fullusers.password":                               "password",
+		"fullusers.status":                                 "status",
+		"fullusers.status.failedloginsincesuccessfullogin": "failedLoginSinceSuccessfulLogin",
+		"fullusers.status.federationprovidername":          "federationProviderName",
+		"fullusers.status.federationprovideruuid":          "federationProviderUUID",
+		"fullusers.status.isfederateduser":                 "isFederatedUser",
+		"fullusers.status.lastfailedlogin":                 "lastFailedLogin",
+		"fullusers.status.lastsuccessfullogin":             "lastSuccessfulLogin",
+		"fullusers.status.temporarilydisabled":             "temporarilyDisabled",
+		"fullusers.username":                               "username",
+		"fullusers.uuid":                                   "uuid",
+		"isfederated":                                      "isFederated",
+		"name":                                             "name",
+		"roles":                                            "roles",
+		"users":                                            "users",
+	},
+	"GET /core/admin/groups/{uuid}/roles": {
+		"description":               "description",
+		"name":                      "name",
+		"namespace":                 "namespace",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"GET /core/admin/namespaces/{namespace}/roles": {
+		"description":               "description",
+		"name":                      "name",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"POST /core/admin/namespaces/{namespace}/roles": {
+		"description":               "description",
+		"name":                      "name",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"GET /core/admin/namespaces/{namespace}/roles/{name}": {
+		"description":               "description",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"PUT /core/admin/namespaces/{namespace}/roles/{name}": {
+		"description":               "description",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"GET /core/admin/passwordpolicy": {
+		"allowusername":              "allowUserName",
+		"digits":                     "digits",
+		"forceexpiredpasswordchange": "forceExpiredPasswordChange",
+		"hashingalgorithm":           "hashingAlgorithm",
+		"length":                     "length",
+		"lowercase":                  "lowerCase",
+		"maxfailurewaitseconds":      "maxFailureWaitSeconds",
+		"maxloginfailure":            "maxLoginFailure",
+		"passwordhistory":            "passwordHistory",
+		"permanentlockout":           "permanentLockout",
+		"resettimeseconds":           "resetTimeSeconds",
+		"specialchars":               "specialChars",
+		"uppercase":                  "upperCase",
+	},
+	"PUT /core/admin/passwordpolicy": {
+		"allowusername":              "allowUserName",
+		"digits":                     "digits",
+		"forceexpiredpasswordchange": "forceExpiredPasswordChange",
+		"hashingalgorithm":           "hashingAlgorithm",
+		"length":                     "length",
+		"lowercase":                  "lowerCase",
+		"maxfailurewaitseconds":      "maxFailureWaitSeconds",
+		"maxloginfailure":            "maxLoginFailure",
+		"passwordhistory":            "passwordHistory",
+		"permanentlockout":           "permanentLockout",
+		"resettimeseconds":           "resetTimeSeconds",
+		"specialchars":               "specialChars",
+		"uppercase":                  "upperCase",
+	},
+	"GET /core/admin/roles": {
+		"description":               "description",
+		"name":                      "name",
+		"namespace":                 "namespace",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"POST /core/admin/roles": {
+		"description":               "description",
+		"name":                      "name",
+		"namespace":                 "namespace",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"GET /core/admin/roles/{name}": {
+		"description":               "description",
+		"namespace":                 "namespace",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"PUT /core/admin/roles/{name}": {
+		"description":               "description",
+		"namespace":                 "namespace",
+		"resourcerules":             "resourceRules",
+		"resourcerules.apigroups":   "apiGroups",
+		"resourcerules.permissions": "permissions",
+		"resourcerules.resources":   "resources",
+		"tablerules":                "tableRules",
+		"tablerules.path":           "path",
+		"tablerules.permissions":    "permissions",
+		"urlrules":                  "urlRules",
+		"urlrules.path":             "path",
+		"urlrules.permissions":      "permissions",
+	},
+	"GET /core/admin/sessions": {
+		"clients":      "clients",
+		"clients.id":   "id",
+		"clients.name": "name",
+		"id":           "id",
+		"ipaddress":    "ipAddress",
+		"lastaccess":   "lastAccess",
+		"start":        "start",
+		"userid":       "userId",
+		"username":     "username",
+	},
+	"GET /core/admin/users": {
+		"enabled":                                "enabled",
+		"firstname":                              "firstName",
+		"groups":                                 "groups",
+		"lastname":                               "lastName",
+		"maxsessions":                            "maxSessions",
+		"password":                               "password",
+		"status":                                 "status",
+		"status.failedloginsincesuccessfullogin": "failedLoginSinceSuccessfulLogin",
+		"status.federationprovidername":          "federationProviderName",
+		"status.federationprovideruuid":          "federationProviderUUID",
+		"status.isfederateduser":                 "isFederatedUser",
+		"status.lastfailedlogin":                 "lastFailedLogin",
+		"status.lastsuccessfullogin":             "lastSuccessfulLogin",
+		"status.temporarilydisabled":             "temporarilyDisabled",
+		"uuid":                                   "uuid",
+	},
+	"POST /core/admin/users": {
+		"email":                                  "email",
+		"enabled":                                "enabled",
+		"firstname":                              "firstName",
+		"groups":                                 "groups",
+		"lastname":                               "lastName",
+		"maxsessions":                            "maxSessions",
+		"password":                               "password",
+		"status":                                 "status",
+		"status.failedloginsincesuccessfullogin": "failedLoginSinceSuccessfulLogin",
+		"status.federationprovidername":          "federationProviderName",
+		"status.federationprovideruuid":          "federationProviderUUID",
+		"status.isfederateduser":                 "isFederatedUser",
+		"status.lastfailedlogin":                 "lastFailedLogin",
+		"status.lastsuccessfullogin":             "lastSuccessfulLogin",
+		"status.temporarilydisabled":             "temporarilyDisabled",
+		"username":                               "username",
+		"uuid":                                   "uuid",
+	},
+	"GET /core/admin/users/{uuid}": {
+		"email":                                  "email",
+		"enabled":                                "enabled",
+		"firstname":                              "firstName",
+		"groups":                                 "groups",
+		"lastname":                               "lastName",
+		"maxsessions":                            "maxSessions",
+		"password":                               "password",
+		"status":                                 "status",
+		"status.failedloginsincesuccessfullogin": "failedLoginSinceSuccessfulLogin",
+		"status.federationprovidername":          "federationProviderName",
+		"status.federationprovideruuid":          "federationProviderUUID",
+		"status.isfederateduser":                 "isFederatedUser",
+		"status.lastfailedlogin":                 "lastFailedLogin",
+		"status.lastsuccessfullogin":             "lastSuccessfulLogin",
+		"status.temporarilydisabled":             "temporarilyDisabled",
+		"username":                               "username",
+	},
+	"PUT /core/admin/users/{uuid}": {
+		"email":                                  "email",
+		"enabled":                                "enabled",
+		"firstname":                              "firstName",
+		"groups":                                 "groups",
+		"lastname":                               "lastName",
+		"maxsessions":                            "maxSessions",
+		"password":                               "password",
+		"status":                                 "status",
+		"status.failedloginsincesuccessfullogin": "failedLoginSinceSuccessfulLogin",
+		"status.federationprovidername":          "federationProviderName",
+		"status.federationprovideruuid":          "federationProviderUUID",
+		"status.isfederateduser":                 "isFederatedUser",
+		"status.lastfailedlogin":                 "lastFailedLogin",
+		"status.lastsuccessfullogin":             "lastSuccessfulLogin",
+		"status.temporarilydisabled":             "temporarilyDisabled",
+		"username":                               "username",
+	},
+	"PUT /core/admin/users/{uuid}/resetpassword": {
+		"temporary": "temporary",
+		"value":     "value",
+	},
+	"GET /core/admin/users/{uuid}/sessions": {
+		"clients":      "clients",
+		"clients.id":   "id",
+		"clients.name": "name",
+		"id":           "id",
+		"ipaddress":    "ipAddress",
+		"lastaccess":   "lastAccess",
+		"start":        "start",
+		"userid":       "userId",
+		"username":     "username",
+	},
+	"GET /core/alarm/v2/alarms": {
+		"acknowledged":         "acknowledged",
+		"acknowledgeduntil":    "acknowledgedUntil",
+		"additionaltext":       "additionalText",
+		"cleared":              "cleared",
+		"clustermember":        "clusterMember",
+		"clustername":          "clusterName",
+		"description":          "description",
+		"group":                "group",
+		"jspaths":              "jspaths",
+		"kind":                 "kind",
+		"lastacknowledgedby":   "lastAcknowledgedBy",
+		"lastacknowledgedtime": "lastAcknowledgedTime",
+		"lastchanged":          "lastChanged",
+		"lastsuppressedby":     "lastSuppressedBy",
+		"lastsuppressedtime":   "lastSuppressedTime",
+		"name":                 "name",
+		"namespace":            "namespace",
+		"occurrences":          "occurrences",
+		"parentalarms":         "parentAlarms",
+		"probablecause":        "probableCause",
+		"remedialaction":       "remedialAction",
+		"resource":             "resource",
+		"severity":             "severity",
+		"sourcegroup":          "sourceGroup",
+		"sourcekind":           "sourceKind",
+		"sourceresource":       "sourceResource",
+		"suppressed":           "suppressed",
+		"suppresseduntil":      "suppressedUntil",
+		"targetsaffected":      "targetsAffected",
+		"targetsaffected.name": "name",
+		"type":                 "type",
+	},
+	"PUT /core/alarm/v2/alarms": {
+		"name":      "name",
+		"namespace": "namespace",
+	},
+	"DELETE /core/alarm/v2/alarms": {
+		"name":      "name",
+		"namespace": "namespace",
+	},
+	"GET /core/alarm/v2/alarms/{alarm-name}": {
+		"acknowledged":         "acknowledged",
+		"acknowledgeduntil":    "acknowledgedUntil",
+		"additionaltext":       "additionalText",
+		"cleared":              "cleared",
+		"clustermember":        "clusterMember",
+		"clustername":          "clusterName",
+		"description":          "description",
+		"group":                "group",
+		"jspaths":              "jspaths",
+		"kind":                 "kind",
+		"lastacknowledgedby":   "lastAcknowledgedBy",
+		"lastacknowledgedtime": "lastAcknowledgedTime",
+		"lastchanged":          "lastChanged",
+		"lastsuppressedby":     "lastSuppressedBy",
+		"lastsuppressedtime":   "lastSuppressedTime",
+		"name":                 "name",
+		"namespace":            "namespace",
+		"occurrences":          "occurrences",
+		"parentalarms":         "parentAlarms",
+		"probablecause":        "probableCause",
+		"remedialaction":       "remedialAction",
+		"resource":             "resource",
+		"severity":             "severity",
+		"sourcegroup":          "sourceGroup",
+		"sourcekind":           "sourceKind",
+		"sourceresource":       "sourceResource",
+		"suppressed":           "suppressed",
+		"suppresseduntil":      "suppressedUntil",
+		"targetsaffected":      "targetsAffected",
+		"targetsaffected.name": "name",
+		"type":                 "type",
+	},
+	"GET /core/alarm/v2/alarms/{alarm-name}/history": {
+		"alarm":                "alarm",
+		"alarm.acknowledged":   "acknowledged",
+		"alarm.acknowledgedby": "acknowledgedBy",
+		"alarm.additionaltext": "additionalText",
+		"alarm.cleared":        "cleared",
+		"alarm.lastchanged":    "lastChanged",
+		"alarm.name":           "name",
+		"alarm.namespace":      "namespace",
+		"alarm.probablecause":  "probableCause",
+		"alarm.remedialaction": "remedialAction",
+		"index":                "index",
+	},
+	"GET /core/alarm/v2/namespaces/{nsName}/alarms": {
+		"acknowledged":         "acknowledged",
+		"acknowledgeduntil":    "acknowledgedUntil",
+		"additionaltext":       "additionalText",
+		"cleared":              "cleared",
+		"clustermember":        "clusterMember",
+		"clustername":          "clusterName",
+		"description":          "description",
+		"group":                "group",
+		"jspaths":              "jspaths",
+		"kind":                 "kind",
+		"lastacknowledgedby":   "lastAcknowledgedBy",
+		"lastacknowledgedtime": "lastAcknowledgedTime",
+		"lastchanged":          "lastChanged",
+		"lastsuppressedby":     "lastSuppressedBy",
+		"lastsuppressedtime":   "lastSuppressedTime",
+		"name":                 "name",
+		"namespace":            "namespace",
+		"occurrences":          "occurrences",
+		"parentalarms":         "parentAlarms",
+		"probablecause":        "probableCause",
+		"remedialaction":       "remedialAction",
+		"resource":             "resource",
+		"severity":             "severity",
+		"sourcegroup":          "sourceGroup",
+		"sourcekind":           "sourceKind",
+		"sourceresource":       "sourceResource",
+		"suppressed":           "suppressed",
+		"suppresseduntil":      "suppressedUntil",
+		"targetsaffected":      "targetsAffected",
+		"targetsaffected.name": "name",
+		"type":                 "type",
+	},
+	"PUT /core/alarm/v2/namespaces/{nsName}/alarms": {
+		"name":      "name",
+		"namespace": "namespace",
+	},
+	"DELETE /core/alarm/v2/namespaces/{nsName}/alarms": {
+		"name":      "name",
+		"namespace": "namespace",
+	},
+	"GET /core/alarm/v2/namespaces/{nsName}/alarms/{alarm-name}": {
+		"acknowledged":         "acknowledged",
+		"acknowledgeduntil":    "acknowledgedUntil",
+		"additionaltext":       "additionalText",
+		"cleared":              "cleared",
+		"clustermember":        "clusterMember",
+		"clustername":          "clusterName",
+		"description":          "description",
+		"group":                "group",
+		"jspaths":              "jspaths",
+		"kind":                 "kind",
+		"lastacknowledgedby":   "lastAcknowledgedBy",
+		"lastacknowledgedtime": "lastAcknowledgedTime",
+		"lastchanged":          "lastChanged",
+		"lastsuppressedby":     "lastSuppressedBy",
+		"lastsuppressedtime":   "lastSuppressedTime",
+		"name":                 "name",
+		"namespace":            "namespace",
+		"occurrences":          "occurrences",
+		"parentalarms":         "parentAlarms",
+		"probablecause":        "probableCause",
+		"remedialaction":       "remedialAction",
+		"resource":             "resource",
+		"severity":             "severity",
+		"sourcegroup":          "sourceGroup",
+		"sourcekind":           "sourceKind",
+		"sourceresource":       "sourceResource",
+		"suppressed":           "suppressed",
+		"suppresseduntil":      "suppressedUntil",
+		"targetsaffected":      "targetsAffected",
+		"targetsaffected.name": "name",
+		"type":                 "type",
+	},
+	"GET /core/alarm/v2/namespaces/{nsName}/alarms/{alarm-name}/history": {
+		"alarm":                "alarm",
+		"alarm.acknowledged":   "acknowledged",
+		"alarm.acknowledgedby": "acknowledgedBy",
+		"alarm.additionaltext": "additionalText",
+		"alarm.cleared":        "cleared",
+		"alarm.lastchanged":    "lastChanged",
+		"alarm.name":           "name",
+		"alarm.namespace":      "namespace",
+		"alarm.probablecause":  "probableCause",
+		"alarm.remedialaction": "remedialAction",
+		"index":                "index",
+	},
+	"POST /core/autocomplete/v1/fields": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/gvk": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/gvr": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/label": {
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"results":                       "results",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/labels": {
+		"gvk":         "gvk",
+		"gvk.group":   "group",
+		"gvk.kind":    "kind",
+		"gvk.version": "version",
+		"limit":       "limit",
+		"namespace":   "namespace",
+		"results":     "results",
+		"value":       "value",
+	},
+	"POST /core/autocomplete/v1/labelselector": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/query": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/table": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"POST /core/autocomplete/v1/where": {
+		"action":                        "action",
+		"completions":                   "completions",
+		"completions.completion":        "completion",
+		"completions.token":             "token",
+		"details":                       "details",
+		"details.condition":             "condition",
+		"details.field":                 "Field",
+		"details.group":                 "group",
+		"details.kind":                  "kind",
+		"details.query":                 "query",
+		"details.resource":              "resource",
+		"details.subject":               "subject",
+		"details.table":                 "table",
+		"details.tableexpression":       "table-expression",
+		"details.tableprefix":           "table-prefix",
+		"details.tableprefixexpression": "table-prefix-expression",
+		"details.type":                  "type",
+		"details.version":               "version",
+		"details.where":                 "Where",
+		"details.whereexpression":       "where-expression",
+		"error":                         "error",
+		"error.causecollection":         "causeCollection",
+		"error.causeindexedcollection":  "causeIndexedCollection",
+		"error.causeisinternal":         "causeIsInternal",
+		"error.causesimple":             "causeSimple",
+		"error.causewrapped":            "causeWrapped",
+		"error.domain":                  "domain",
+		"error.index":                   "index",
+		"error.message":                 "message",
+		"error.ref":                     "ref",
+		"error.type":                    "type",
+		"error.values":                  "values",
+		"limit":                         "limit",
+		"namespace":                     "namespace",
+		"query":                         "query",
+		"query.autocompletefield":       "autoCompleteField",
+		"query.eql":                     "eql",
+		"query.fields":                  "fields",
+		"query.table":                   "table",
+		"query.where":                   "where",
+		"value":                         "value",
+	},
+	"GET /core/branches/v1/crdiff/{hash}": {
+		"after":  "after",
+		"before": "before",
+	},
+	"GET /core/branches/v1/diffsummary": {
+		"branchheadhash":             "branchHeadHash",
+		"changedcrs":                 "changedCrs",
+		"changedcrs.gvk":             "gvk",
+		"changedcrs.gvk.group":       "group",
+		"changedcrs.gvk.kind":        "kind",
+		"changedcrs.gvk.version":     "version",
+		"changedcrs.hasmore":         "hasMore",
+		"changedcrs.names":           "names",
+		"changedcrs.names.name":      "name",
+		"changedcrs.names.namespace": "namespace",
+		"changedcrs.nextcursor":      "nextCursor",
+		"changedcrscount":            "changedCrsCount",
+		"visiblecrscount":            "visibleCrsCount",
+	},
+	"GET /core/branches/v1/diffsummary/{hash}": {
+		"branchheadhash":             "branchHeadHash",
+		"changedcrs":                 "changedCrs",
+		"changedcrs.gvk":             "gvk",
+		"changedcrs.gvk.group":       "group",
+		"changedcrs.gvk.kind":        "kind",
+		"changedcrs.gvk.version":     "version",
+		"changedcrs.hasmore":         "hasMore",
+		"changedcrs.names":           "names",
+		"changedcrs.names.name":      "name",
+		"changedcrs.names.namespace": "namespace",
+		"changedcrs.nextcursor":      "nextCursor",
+		"changedcrscount":            "changedCrsCount",
+		"visiblecrscount":            "visibleCrsCount",
+	},
+	"POST /core/branches/v1/merge": {
+		"crcount":     "crCount",
+		"description": "description",
+		"mrid":        "mrId",
+	},
+	"POST /core/branches/v1/rebase": {
+		"mainendhash":          "mainEndHash",
+		"mainendtransaction":   "mainEndTransaction",
+		"mainstarthash":        "mainStartHash",
+		"mainstarttransaction": "mainStartTransaction",
+		"mergerequestid":       "mergeRequestId",
+	},
+	"POST /core/branches/v1/restore": {
+		"mainendhash":          "mainEndHash",
+		"mainendtransaction":   "mainEndTransaction",
+		"mainstarthash":        "mainStartHash",
+		"mainstarttransaction": "mainStartTransaction",
+	},
+	"GET /core/branches/v1/status": {
+		"branchname":              "branchName",
+		"maincluster":             "mainCluster",
+		"maincluster.domainname":  "domainName",
+		"maincluster.httpsport":   "httpsPort",
+		"maincluster.ipv4address": "ipv4Address",
+		"maincluster.ipv6address": "ipv6Address",
+		"mode":                    "mode",
+	},
+	"GET /core/chat/v1/chats": {
+		"created":      "created",
+		"id":           "id",
+		"lastupdated":  "lastUpdated",
+		"messagecount": "messageCount",
+		"name":         "name",
+	},
+	"GET /core/chat/v1/chats/{chatId}": {
+		"created":        "created",
+		"id":             "id",
+		"lastupdated":    "lastUpdated",
+		"messagecount":   "messageCount",
+		"messages":       "messages",
+		"messages.role":  "role",
+		"messages.type":  "type",
+		"messages.value": "value",
+		"name":           "name",
+	},
+	"PUT /core/chat/v1/chats/{chatId}": {
+		"created":      "created",
+		"id":           "id",
+		"lastupdated":  "lastUpdated",
+		"messagecount": "messageCount",
+		"name":         "name",
+	},
+	"POST /core/mergerequest/v1": {
+		"crs":                                         "crs",
+		"crs.prior":                                   "prior",
+		"crs.prior.cr":                                "cr",
+		"crs.prior.cr.apiversion":                     "apiVersion",
+		"crs.prior.cr.kind":                           "kind",
+		"crs.prior.cr.metadata":                       "metadata",
+		"crs.prior.cr.metadata.annotations":           "annotations",
+		"crs.prior.cr.metadata.labels":                "labels",
+		"crs.prior.cr.metadata.name":                  "name",
+		"crs.prior.cr.metadata.namespace":             "namespace",
+		"crs.prior.cr.spec":                           "spec",
+		"crs.prior.notpresent":                        "notPresent",
+		"crs.type":                                    "type",
+		"crs.type.create":                             "create",
+		"crs.type.create.value":                       "value",
+		"crs.type.create.value.apiversion":            "apiVersion",
+		"crs.type.create.value.kind":                  "kind",
+		"crs.type.create.value.metadata":              "metadata",
+		"crs.type.create.value.metadata.annotations":  "annotations",
+		"crs.type.create.value.metadata.labels":       "labels",
+		"crs.type.create.value.metadata.name":         "name",
+		"crs.type.create.value.metadata.namespace":    "namespace",
+		"crs.type.create.value.spec":                  "spec",
+		"crs.type.delete":                             "delete",
+		"crs.type.delete.gvk":                         "gvk",
+		"crs.type.delete.gvk.group":                   "group",
+		"crs.type.delete.gvk.kind":                    "kind",
+		"crs.type.delete.gvk.version":                 "version",
+		"crs.type.delete.name":                        "name",
+		"crs.type.delete.namespace":                   "namespace",
+		"crs.type.modify":                             "modify",
+		"crs.type.modify.value":                       "value",
+		"crs.type.modify.value.apiversion":            "apiVersion",
+		"crs.type.modify.value.kind":                  "kind",
+		"crs.type.modify.value.metadata":              "metadata",
+		"crs.type.modify.value.metadata.annotations":  "annotations",
+		"crs.type.modify.value.metadata.labels":       "labels",
+		"crs.type.modify.value.metadata.name":         "name",
+		"crs.type.modify.value.metadata.namespace":    "namespace",
+		"crs.type.modify.value.spec":                  "spec",
+		"crs.type.patch":                              "patch",
+		"crs.type.patch.patchops":                     "patchOps",
+		"crs.type.patch.patchops.from":                "from",
+		"crs.type.patch.patchops.op":                  "op",
+		"crs.type.patch.patchops.path":                "path",
+		"crs.type.patch.patchops.value":               "value",
+		"crs.type.patch.patchops.xpermissive":         "x-permissive",
+		"crs.type.patch.target":                       "target",
+		"crs.type.patch.target.gvk":                   "gvk",
+		"crs.type.patch.target.gvk.group":             "group",
+		"crs.type.patch.target.gvk.kind":              "kind",
+		"crs.type.patch.target.gvk.version":           "version",
+		"crs.type.patch.target.name":                  "name",
+		"crs.type.patch.target.namespace":             "namespace",
+		"crs.type.replace":                            "replace",
+		"crs.type.replace.value":                      "value",
+		"crs.type.replace.value.apiversion":           "apiVersion",
+		"crs.type.replace.value.kind":                 "kind",
+		"crs.type.replace.value.metadata":             "metadata",
+		"crs.type.replace.value.metadata.annotations": "annotations",
+		"crs.type.replace.value.metadata.labels":      "labels",
+		"crs.type.replace.value.metadata.name":        "name",
+		"crs.type.replace.value.metadata.namespace":   "namespace",
+		"crs.type.replace.value.spec":                 "spec",
+		"description":                                 "description",
+		"id":                                          "id",
+	},
+	"GET /core/mergerequest/v1/{mergeRequestId}": {
+		"crs":                                         "crs",
+		"crs.prior":                                   "prior",
+		"crs.prior.cr":                                "cr",
+		"crs.prior.cr.apiversion":                     "apiVersion",
+		"crs.prior.cr.kind":                           "kind",
+		"crs.prior.cr.metadata":                       "metadata",
+		"crs.prior.cr.metadata.annotations":           "annotations",
+		"crs.prior.cr.metadata.labels":                "labels",
+		"crs.prior.cr.metadata.name":                  "name",
+		"crs.prior.cr.metadata.namespace":             "namespace",
+		"crs.prior.cr.spec":                           "spec",
+		"crs.prior.notpresent":                        "notPresent",
+		"crs.type":                                    "type",
+		"crs.type.create":                             "create",
+		"crs.type.create.value":                       "value",
+		"crs.type.create.value.apiversion":            "apiVersion",
+		"crs.type.create.value.kind":                  "kind",
+		"crs.type.create.value.metadata":              "metadata",
+		"crs.type.create.value.metadata.annotations":  "annotations",
+		"crs.type.create.value.metadata.labels":       "labels",
+		"crs.type.create.value.metadata.name":         "name",
+		"crs.type.create.value.metadata.namespace":    "namespace",
+		"crs.type.create.value.spec":                  "spec",
+		"crs.type.delete":                             "delete",
+		"crs.type.delete.gvk":                         "gvk",
+		"crs.type.delete.gvk.group":                   "group",
+		"crs.type.delete.gvk.kind":                    "kind",
+		"crs.type.delete.gvk.version":                 "version",
+		"crs.type.delete.name":                        "name",
+		"crs.type.delete.namespace":                   "namespace",
+		"crs.type.modify":                             "modify",
+		"crs.type.modify.value":                       "value",
+		"crs.type.modify.value.apiversion":            "apiVersion",
+		"crs.type.modify.value.kind":                  "kind",
+		"crs.type.modify.value.metadata":              "metadata",
+		"crs.type.modify.value.metadata.annotations":  "annotations",
+		"crs.type.modify.value.metadata.labels":       "labels",
+		"crs.type.modify.value.metadata.name":         "name",
+		"crs.type.modify.value.metadata.namespace":    "namespace",
+		"crs.type.modify.value.spec":                  "spec",
+		"crs.type.patch":                              "patch",
+		"crs.type.patch.patchops":                     "patchOps",
+		"crs.type.patch.patchops.from":                "from",
+		"crs.type.patch.patchops.op":                  "op",
+		"crs.type.patch.patchops.path":                "path",
+		"crs.type.patch.patchops.value":               "value",
+		"crs.type.patch.patchops.xpermissive":         "x-permissive",
+		"crs.type.patch.target":                       "target",
+		"crs.type.patch.target.gvk":                   "gvk",
+		"crs.type.patch.target.gvk.group":             "group",
+		"crs.type.patch.target.gvk.kind":              "kind",
+		"crs.type.patch.target.gvk.version":           "version",
+		"crs.type.patch.target.name":                  "name",
+		"crs.type.patch.target.namespace":             "namespace",
+		"crs.type.replace":                            "replace",
+		"crs.type.replace.value":                      "value",
+		"crs.type.replace.value.apiversion":           "apiVersion",
+		"crs.type.replace.value.kind":                 "kind",
+		"crs.type.replace.value.metadata":             "metadata",
+		"crs.type.replace.value.metadata.annotations": "annotations",
+		"crs.type.replace.value.metadata.labels":      "labels",
+		"crs.type.replace.value.metadata.name":        "name",
+		"crs.type.replace.value.metadata.namespace":   "namespace",
+		"crs.type.replace.value.spec":                 "spec",
+		"description":                                 "description",
+	},
+	"PUT /core/mergerequest/v1/{mergeRequestId}": {
+		"crs":                                         "crs",
+		"crs.prior":                                   "prior",
+		"crs.prior.cr":                                "cr",
+		"crs.prior.cr.apiversion":                     "apiVersion",
+		"crs.prior.cr.kind":                           "kind",
+		"crs.prior.cr.metadata":                       "metadata",
+		"crs.prior.cr.metadata.annotations":           "annotations",
+		"crs.prior.cr.metadata.labels":                "labels",
+		"crs.prior.cr.metadata.name":                  "name",
+		"crs.prior.cr.metadata.namespace":             "namespace",
+		"crs.prior.cr.spec":                           "spec",
+		"crs.prior.notpresent":                        "notPresent",
+		"crs.type":                                    "type",
+		"crs.type.create":                             "create",
+		"crs.type.create.value":                       "value",
+		"crs.type.create.value.apiversion":            "apiVersion",
+		"crs.type.create.value.kind":                  "kind",
+		"crs.type.create.value.metadata":              "metadata",
+		"crs.type.create.value.metadata.annotations":  "annotations",
+		"crs.type.create.value.metadata.labels":       "labels",
+		"crs.type.create.value.metadata.name":         "name",
+		"crs.type.create.value.metadata.namespace":    "namespace",
+		"crs.type.create.value.spec":                  "spec",
+		"crs.type.delete":                             "delete",
+		"crs.type.delete.gvk":                         "gvk",
+		"crs.type.delete.gvk.group":                   "group",
+		"crs.type.delete.gvk.kind":                    "kind",
+		"crs.type.delete.gvk.version":                 "version",
+		"crs.type.delete.name":                        "name",
+		"crs.type.delete.namespace":                   "namespace",
+		"crs.type.modify":                             "modify",
+		"crs.type.modify.value":                       "value",
+		"crs.type.modify.value.apiversion":            "apiVersion",
+		"crs.type.modify.value.kind":                  "kind",
+		"crs.type.modify.value.metadata":              "metadata",
+		"crs.type.modify.value.metadata.annotations":  "annotations",
+		"crs.type.modify.value.metadata.labels":       "labels",
+		"crs.type.modify.value.metadata.name":         "name",
+		"crs.type.modify.value.metadata.namespace":    "namespace",
+		"crs.type.modify.value.spec":                  "spec",
+		"crs.type.patch":                              "patch",
+		"crs.type.patch.patchops":                     "patchOps",
+		"crs.type.patch.patchops.from":                "from",
+		"crs.type.patch.patchops.op":                  "op",
+		"crs.type.patch.patchops.path":                "path",
+		"crs.type.patch.patchops.value":               "value",
+		"crs.type.patch.patchops.xpermissive":         "x-permissive",
+		"crs.type.patch.target":                       "target",
+		"crs.type.patch.target.gvk":                   "gvk",
+		"crs.type.patch.target.gvk.group":             "group",
+		"crs.type.patch.target.gvk.kind":              "kind",
+		"crs.type.patch.target.gvk.version":           "version",
+		"crs.type.patch.target.name":                  "name",
+		"crs.type.patch.target.namespace":             "namespace",
+		"crs.type.replace":                            "replace",
+		"crs.type.replace.value":                      "value",
+		"crs.type.replace.value.apiversion":           "apiVersion",
+		"crs.type.replace.value.kind":                 "kind",
+		"crs.type.replace.value.metadata":             "metadata",
+		"crs.type.replace.value.metadata.annotations": "annotations",
+		"crs.type.replace.value.metadata.labels":      "labels",
+		"crs.type.replace.value.metadata.name":        "name",
+		"crs.type.replace.value.metadata.namespace":   "namespace",
+		"crs.type.replace.value.spec":                 "spec",
+		"description":                                 "description",
+	},
+	"POST /core/mergerequest/v1/{mergeRequestId}/merge": {
+		"dryrun": "dryRun",
+		"id":     "id",
+	},
+	"GET /core/nodeconfig/v2/namespaces/{nsName}/nodes/{nodeName}": {
+		"annotations":                 "annotations",
+		"annotations.cr":              "cr",
+		"annotations.cr.gvk":          "gvk",
+		"annotations.cr.gvk.group":    "group",
+		"annotations.cr.gvk.kind":     "kind",
+		"annotations.cr.gvk.version":  "version",
+		"annotations.cr.name":         "name",
+		"annotations.cr.namespace":    "namespace",
+		"annotations.lines":           "lines",
+		"annotations.lines.endline":   "endLine",
+		"annotations.lines.startline": "startLine",
+		"running":                     "running",
+	},
+	"GET /core/query/v1": {
+		"data":       "data",
+		"jsonschema": "jsonSchema",
+	},
+	"POST /core/query/v1/convert": {
+		"jspath":      "jsPath",
+		"query":       "query",
+		"table":       "table",
+		"whereclause": "whereClause",
+	},
+	"GET /core/query/v1/eql": {
+		"data":       "data",
+		"jsonschema": "jsonSchema",
+	},
+	"GET /core/query/v1/eql/autocomplete": {
+		"completions":            "completions",
+		"completions.completion": "completion",
+		"completions.token":      "token",
+	},
+	"GET /core/query/v1/nql": {
+		"data":       "data",
+		"jsonschema": "jsonSchema",
+	},
+	"POST /core/query/v1/parse/eql": {
+		"eqlparsed":                                       "eqlParsed",
+		"eqlparsed.fields":                                "fields",
+		"eqlparsed.fields.aggregate":                      "aggregate",
+		"eqlparsed.fields.aggregate.op":                   "op",
+		"eqlparsed.fields.aggregate.path":                 "path",
+		"eqlparsed.fields.aggregate.path.path":            "path",
+		"eqlparsed.fields.aggregate.path.relative":        "relative",
+		"eqlparsed.fields.alias":                          "alias",
+		"eqlparsed.fields.concat":                         "concat",
+		"eqlparsed.fields.concat.operands":                "operands",
+		"eqlparsed.fields.concat.operands.const":          "const",
+		"eqlparsed.fields.concat.operands.path":           "path",
+		"eqlparsed.fields.concat.operands.path.path":      "path",
+		"eqlparsed.fields.concat.operands.path.relative":  "relative",
+		"eqlparsed.fields.path":                           "path",
+		"eqlparsed.fields.path.path":                      "path",
+		"eqlparsed.fields.path.relative":                  "relative",
+		"eqlparsed.jspath":                                "jsPath",
+		"eqlparsed.limit":                                 "limit",
+		"eqlparsed.orderby":                               "orderBy",
+		"eqlparsed.orderby.aggregate":                     "aggregate",
+		"eqlparsed.orderby.aggregate.op":                  "op",
+		"eqlparsed.orderby.aggregate.path":                "path",
+		"eqlparsed.orderby.aggregate.path.path":           "path",
+		"eqlparsed.orderby.aggregate.path.relative":       "relative",
+		"eqlparsed.orderby.concat":                        "concat",
+		"eqlparsed.orderby.concat.operands":               "operands",
+		"eqlparsed.orderby.concat.operands.const":         "const",
+		"eqlparsed.orderby.concat.operands.path":          "path",
+		"eqlparsed.orderby.concat.operands.path.path":     "path",
+		"eqlparsed.orderby.concat.operands.path.relative": "relative",
+		"eqlparsed.orderby.descending":                    "descending",
+		"eqlparsed.orderby.path":                          "path",
+		"eqlparsed.orderby.path.path":                     "path",
+		"eqlparsed.orderby.path.relative":                 "relative",
+		"eqlparsed.updatedeltams":                         "updateDeltaMs",
+		"eqlparsed.updatesamplems":                        "updateSampleMs",
+		"eqlparsed.whereexpr":                             "whereExpr",
+		"eqlparsed.whereexpr.expr":                        "expr",
+		"eqlparsed.whereexpr.expr.left":                   "left",
+		"eqlparsed.whereexpr.expr.op":                     "op",
+		"eqlparsed.whereexpr.expr.right":                  "right",
+		"eqlparsed.whereexpr.path":                        "path",
+		"eqlparsed.whereexpr.path.path":                   "path",
+		"eqlparsed.whereexpr.path.relative":               "relative",
+		"eqlparsed.whereexpr.value":                       "value",
+		"eqlparsed.whereexpr.value.values":                "values",
+		"eqlparsed.whereexpr.value.values.value":          "value",
+		"eqlstring":                                       "eqlString",
+		"minimizeexpr":                                    "minimizeExpr",
+	},
+	"POST /core/query/v1/parse/where": {
+		"minimizeexpr":                   "minimizeExpr",
+		"whereparsed":                    "whereParsed",
+		"whereparsed.expr":               "expr",
+		"whereparsed.expr.left":          "left",
+		"whereparsed.expr.op":            "op",
+		"whereparsed.expr.right":         "right",
+		"whereparsed.path":               "path",
+		"whereparsed.path.path":          "path",
+		"whereparsed.path.relative":      "relative",
+		"whereparsed.value":              "value",
+		"whereparsed.value.values":       "values",
+		"whereparsed.value.values.value": "value",
+		"wherestring":                    "whereString",
+	},
+	"GET /core/store/v1/appsettingsdefinition/catalog/{catalog}/app/{appId}": {
+		"definition": "definition",
+	},
+	"GET /core/store/v1/appsummaries": {
+		"appid":                          "appId",
+		"catalogs":                       "catalogs",
+		"categories":                     "categories",
+		"description":                    "description",
+		"hassettings":                    "hasSettings",
+		"infoversion":                    "infoVersion",
+		"infoversion.appid":              "appId",
+		"infoversion.catalog":            "catalog",
+		"infoversion.commithash":         "commitHash",
+		"infoversion.semver":             "semVer",
+		"infoversionmetadata":            "infoVersionMetadata",
+		"infoversionmetadata.compatible": "compatible",
+		"infoversionmetadata.incompatibilityreason":      "incompatibilityReason",
+		"infoversionmetadata.publishedtime":              "publishedTime",
+		"installedversion":                               "installedVersion",
+		"installedversion.appid":                         "appId",
+		"installedversion.catalog":                       "catalog",
+		"installedversion.commithash":                    "commitHash",
+		"installedversion.semver":                        "semVer",
+		"installedversionmetadata":                       "installedVersionMetadata",
+		"installedversionmetadata.compatible":            "compatible",
+		"installedversionmetadata.incompatibilityreason": "incompatibilityReason",
+		"installedversionmetadata.publishedtime":         "publishedTime",
+		"latestversion":                                  "latestVersion",
+		"latestversion.appid":                            "appId",
+		"latestversion.catalog":                          "catalog",
+		"latestversion.commithash":                       "commitHash",
+		"latestversion.semver":                           "semVer",
+		"latestversionmetadata":                          "latestVersionMetadata",
+		"latestversionmetadata.compatible":               "compatible",
+		"latestversionmetadata.incompatibilityreason":    "incompatibilityReason",
+		"latestversionmetadata.publishedtime":            "publishedTime",
+		"supportedendpoints":                             "supportedEndpoints",
+		"title":                                          "title",
+		"vendor":                                         "vendor",
+		"vendortitle":                                    "vendorTitle",
+	},
+	"GET /core/store/v1/appsummaries/app/{appId}": {
+		"catalogs":                       "catalogs",
+		"categories":                     "categories",
+		"description":                    "description",
+		"hassettings":                    "hasSettings",
+		"infoversion":                    "infoVersion",
+		"infoversion.appid":              "appId",
+		"infoversion.catalog":            "catalog",
+		"infoversion.commithash":         "commitHash",
+		"infoversion.semver":             "semVer",
+		"infoversionmetadata":            "infoVersionMetadata",
+		"infoversionmetadata.compatible": "compatible",
+		"infoversionmetadata.incompatibilityreason":      "incompatibilityReason",
+		"infoversionmetadata.publishedtime":              "publishedTime",
+		"installedversion":                               "installedVersion",
+		"installedversion.appid":                         "appId",
+		"installedversion.catalog":                       "catalog",
+		"installedversion.commithash":                    "commitHash",
+		"installedversion.semver":                        "semVer",
+		"installedversionmetadata":                       "installedVersionMetadata",
+		"installedversionmetadata.compatible":            "compatible",
+		"installedversionmetadata.incompatibilityreason": "incompatibilityReason",
+		"installedversionmetadata.publishedtime":         "publishedTime",
+		"latestversion":                                  "latestVersion",
+		"latestversion.appid":                            "appId",
+		"latestversion.catalog":                          "catalog",
+		"latestversion.commithash":                       "commitHash",
+		"latestversion.semver":                           "semVer",
+		"latestversionmetadata":                          "latestVersionMetadata",
+		"latestversionmetadata.compatible":               "compatible",
+		"latestversionmetadata.incompatibilityreason":    "incompatibilityReason",
+		"latestversionmetadata.publishedtime":            "publishedTime",
+		"supportedendpoints":                             "supportedEndpoints",
+		"title":                                          "title",
+		"vendor":                                         "vendor",
+		"vendortitle":                                    "vendorTitle",
+	},
+	"GET /core/store/v1/installedsettings/app/{appId}": {
+		"settings": "settings",
+	},
+	"GET /core/store/v1/manifest/catalog/{catalog}/app/{appId}": {
+		"manifest":                       "manifest",
+		"metadata":                       "metadata",
+		"metadata.compatible":            "compatible",
+		"metadata.incompatibilityreason": "incompatibilityReason",
+		"metadata.publishedtime":         "publishedTime",
+		"version":                        "version",
+		"version.appid":                  "appId",
+		"version.catalog":                "catalog",
+		"version.commithash":             "commitHash",
+		"version.semver":                 "semVer",
+	},
+	"GET /core/store/v1/requirementsgraph/catalog/{catalog}/app/{appId}": {
+		"graphitems":                                "graphItems",
+		"graphitems.appid":                          "appId",
+		"graphitems.installedappversion":            "installedAppVersion",
+		"graphitems.installedappversion.appid":      "appId",
+		"graphitems.installedappversion.catalog":    "catalog",
+		"graphitems.installedappversion.commithash": "commitHash",
+		"graphitems.installedappversion.semver":     "semVer",
+		"graphitems.relation":                       "relation",
+		"graphitems.requires":                       "requires",
+		"graphitems.requires.appid":                 "appId",
+		"graphitems.requires.constraint":            "constraint",
+		"graphitems.targetappversion":               "targetAppVersion",
+		"graphitems.targetappversion.appid":         "appId",
+		"graphitems.targetappversion.catalog":       "catalog",
+		"graphitems.targetappversion.commithash":    "commitHash",
+		"graphitems.targetappversion.semver":        "semVer",
+		"state":                                     "state",
+	},
+	"GET /core/store/v1/versions/app/{appId}": {
+		"metadata":                       "metadata",
+		"metadata.compatible":            "compatible",
+		"metadata.incompatibilityreason": "incompatibilityReason",
+		"metadata.publishedtime":         "publishedTime",
+		"version":                        "version",
+		"version.appid":                  "appId",
+		"version.catalog":                "catalog",
+		"version.commithash":             "commitHash",
+		"version.semver":                 "semVer",
+	},
+	"GET /core/topology/v1": {
+		"endpoints":                             "endpoints",
+		"endpoints.attributes":                  "attributes",
+		"endpoints.attributes.type":             "type",
+		"endpoints.attributes.uidescription":    "ui_description",
+		"endpoints.attributes.uidescriptionkey": "ui_description_key",
+		"endpoints.attributes.uiname":           "ui_name",
+		"endpoints.attributes.uinamekey":        "ui_name_key",
+		"endpoints.schema":                      "schema",
+		"endpoints.schema.group":                "group",
+		"endpoints.schema.kind":                 "kind",
+		"endpoints.schema.version":              "version",
+		"endpoints.subtitle":                    "subtitle",
+		"endpoints.subtitlekey":                 "subtitle_key",
+		"group":                                 "group",
+		"grouping":                              "grouping",
+		"grouping.group":                        "group",
+		"grouping.kind":                         "kind",
+		"grouping.version":                      "version",
+		"links":                                 "links",
+		"links.attributes":                      "attributes",
+		"links.attributes.type":                 "type",
+		"links.attributes.uidescription":        "ui_description",
+		"links.attributes.uidescriptionkey":     "ui_description_key",
+		"links.attributes.uiname":               "ui_name",
+		"links.attributes.uinamekey":            "ui_name_key",
+		"links.schema":                          "schema",
+		"links.schema.group":                    "group",
+		"links.schema.kind":                     "kind",
+		"links.schema.version":                  "version",
+		"links.subtitle":                        "subtitle",
+		"links.subtitlekey":                     "subtitle_key",
+		"name":                                  "name",
+		"nodes":                                 "nodes",
+		"nodes.attributes":                      "attributes",
+		"nodes.attributes.type":                 "type",
+		"nodes.attributes.uidescription":        "ui_description",
+		"nodes.attributes.uidescriptionkey":     "ui_description_key",
+		"nodes.attributes.uiname":               "ui_name",
+		"nodes.attributes.uinamekey":            "ui_name_key",
+		"nodes.schema":                          "schema",
+		"nodes.schema.group":                    "group",
+		"nodes.schema.kind":                     "kind",
+		"nodes.schema.version":                  "version",
+		"nodes.subtitle":                        "subtitle",
+		"nodes.subtitlekey":                     "subtitle_key",
+		"uidescription":                         "ui_description",
+		"uidescriptionkey":                      "ui_description_key",
+		"uiname":                                "ui_name",
+		"uinamekey":                             "ui_name_key",
+		"version":                               "version",
+	},
+	"GET /core/topology/v1/{topologyName}": {
+		"endpoints":                             "endpoints",
+		"endpoints.attributes":                  "attributes",
+		"endpoints.attributes.type":             "type",
+		"endpoints.attributes.uidescription":    "ui_description",
+		"endpoints.attributes.uidescriptionkey": "ui_description_key",
+		"endpoints.attributes.uiname":           "ui_name",
+		"endpoints.attributes.uinamekey":        "ui_name_key",
+		"endpoints.schema":                      "schema",
+		"endpoints.schema.group":                "group",
+		"endpoints.schema.kind":                 "kind",
+		"endpoints.schema.version":              "version",
+		"endpoints.subtitle":                    "subtitle",
+		"endpoints.subtitlekey":                 "subtitle_key",
+		"group":                                 "group",
+		"grouping":                              "grouping",
+		"grouping.group":                        "group",
+		"grouping.kind":                         "kind",
+		"grouping.version":                      "version",
+		"links":                                 "links",
+		"links.attributes":                      "attributes",
+		"links.attributes.type":                 "type",
+		"links.attributes.uidescription":        "ui_description",
+		"links.attributes.uidescriptionkey":     "ui_description_key",
+		"links.attributes.uiname":               "ui_name",
+		"links.attributes.uinamekey":            "ui_name_key",
+		"links.schema":                          "schema",
+		"links.schema.group":                    "group",
+		"links.schema.kind":                     "kind",
+		"links.schema.version":                  "version",
+		"links.subtitle":                        "subtitle",
+		"links.subtitlekey":                     "subtitle_key",
+		"name":                                  "name",
+		"nodes":                                 "nodes",
+		"nodes.attributes":                      "attributes",
+		"nodes.attributes.type":                 "type",
+		"nodes.attributes.uidescription":        "ui_description",
+		"nodes.attributes.uidescriptionkey":     "ui_description_key",
+		"nodes.attributes.uiname":               "ui_name",
+		"nodes.attributes.uinamekey":            "ui_name_key",
+		"nodes.schema":                          "schema",
+		"nodes.schema.group":                    "group",
+		"nodes.schema.kind":                     "kind",
+		"nodes.schema.version":                  "version",
+		"nodes.subtitle":                        "subtitle",
+		"nodes.subtitlekey":                     "subtitle_key",
+		"uidescription":                         "ui_description",
+		"uidescriptionkey":                      "ui_description_key",
+		"uiname":                                "ui_name",
+		"uinamekey":                             "ui_name_key",
+		"version":                               "version",
+	},
+	"GET /core/topology/v1/{topologyName}/groupings": {
+		"apiversion":                 "apiVersion",
+		"items":                      "items",
+		"items.apiversion":           "apiVersion",
+		"items.kind":                 "kind",
+		"items.metadata":             "metadata",
+		"items.metadata.annotations": "annotations",
+		"items.metadata.labels":      "labels",
+		"items.metadata.name":        "name",
+		"items.metadata.namespace":   "namespace",
+		"items.spec":                 "spec",
+		"items.status":               "status",
+		"kind":                       "kind",
+	},
+	"GET /core/topology/v1/{topologyName}/groupings/{groupingName}": {
+		"apiversion":           "apiVersion",
+		"kind":                 "kind",
+		"metadata":             "metadata",
+		"metadata.annotations": "annotations",
+		"metadata.labels":      "labels",
+		"metadata.name":        "name",
+		"metadata.namespace":   "namespace",
+		"spec":                 "spec",
+		"status":               "status",
+	},
+	"GET /core/topology/v1/{topologyName}/overlay": {
+		"endpointattrqueries":                             "endpoint_attr_queries",
+		"endpointattrqueries.attributes":                  "attributes",
+		"endpointattrqueries.attributes.uidescription":    "ui_description",
+		"endpointattrqueries.attributes.uidescriptionkey": "ui_description_key",
+		"endpointattrqueries.attributes.uiname":           "ui_name",
+		"endpointattrqueries.attributes.uinamekey":        "ui_name_key",
+		"endpointattrqueries.query":                       "query",
+		"endpointstate":                                   "endpoint_state",
+		"endpointstate.color":                             "color",
+		"endpointstate.uidescription":                     "ui_description",
+		"endpointstate.uidescriptionkey":                  "ui_description_key",
+		"endpointstate.uiname":                            "ui_name",
+		"endpointstate.uinamekey":                         "ui_name_key",
+		"endpointstate.value":                             "value",
+		"endpointstate.weight":                            "weight",
+		"endpointstateheading":                            "endpoint_state_heading",
+		"endpointstateheadingkey":                         "endpoint_state_heading_key",
+		"group":                                           "group",
+		"linkattrqueries":                                 "link_attr_queries",
+		"linkattrqueries.attributes":                      "attributes",
+		"linkattrqueries.attributes.uidescription":        "ui_description",
+		"linkattrqueries.attributes.uidescriptionkey":     "ui_description_key",
+		"linkattrqueries.attributes.uiname":               "ui_name",
+		"linkattrqueries.attributes.uinamekey":            "ui_name_key",
+		"linkattrqueries.query":                           "query",
+		"linkstate":                                       "link_state",
+		"linkstate.color":                                 "color",
+		"linkstate.uidescription":                         "ui_description",
+		"linkstate.uidescriptionkey":                      "ui_description_key",
+		"linkstate.uiname":                                "ui_name",
+		"linkstate.uinamekey":                             "ui_name_key",
+		"linkstate.value":                                 "value",
+		"linkstate.weight":                                "weight",
+		"linkstateheading":                                "link_state_heading",
+		"linkstateheadingkey":                             "link_state_heading_key",
+		"name":                                            "name",
+		"nodeattrqueries":                                 "node_attr_queries",
+		"nodeattrqueries.attributes":                      "attributes",
+		"nodeattrqueries.attributes.uidescription":        "ui_description",
+		"nodeattrqueries.attributes.uidescriptionkey":     "ui_description_key",
+		"nodeattrqueries.attributes.uiname":               "ui_name",
+		"nodeattrqueries.attributes.uinamekey":            "ui_name_key",
+		"nodeattrqueries.query":                           "query",
+		"nodebadge":                                       "node_badge",
+		"nodebadge.badgename":                             "badge_name",
+		"nodebadge.badgepath":                             "badge_path",
+		"nodebadge.color":                                 "color",
+		"nodebadge.navtarget":                             "nav_target",
+		"nodebadge.uidescription":                         "ui_description",
+		"nodebadge.uidescriptionkey":                      "ui_description_key",
+		"nodebadge.uiname":                                "ui_name",
+		"nodebadge.uinamekey":                             "ui_name_key",
+		"nodebadge.value":                                 "value",
+		"nodebadge.weight":                                "weight",
+		"nodestate":                                       "node_state",
+		"nodestate.color":                                 "color",
+		"nodestate.uidescription":                         "ui_description",
+		"nodestate.uidescriptionkey":                      "ui_description_key",
+		"nodestate.uiname":                                "ui_name",
+		"nodestate.uinamekey":                             "ui_name_key",
+		"nodestate.value":                                 "value",
+		"nodestate.weight":                                "weight",
+		"nodestateheading":                                "node_state_heading",
+		"nodestateheadingkey":                             "node_state_heading_key",
+		"uidescription":                                   "ui_description",
+		"uidescriptionkey":                                "ui_description_key",
+		"uiname":                                          "ui_name",
+		"uinamekey":                                       "ui_name_key",
+		"version":                                         "version",
+	},
+	"GET /core/topology/v1/{topologyName}/overlay/{overlayName}": {
+		"endpointattrqueries":                             "endpoint_attr_queries",
+		"endpointattrqueries.attributes":                  "attributes",
+		"endpointattrqueries.attributes.uidescription":    "ui_description",
+		"endpointattrqueries.attributes.uidescriptionkey": "ui_description_key",
+		"endpointattrqueries.attributes.uiname":           "ui_name",
+		"endpointattrqueries.attributes.uinamekey":        "ui_name_key",
+		"endpointattrqueries.query":                       "query",
+		"endpointstate":                                   "endpoint_state",
+		"endpointstate.color":                             "color",
+		"endpointstate.uidescription":                     "ui_description",
+		"endpointstate.uidescriptionkey":                  "ui_description_key",
+		"endpointstate.uiname":                            "ui_name",
+		"endpointstate.uinamekey":                         "ui_name_key",
+		"endpointstate.value":                             "value",
+		"endpointstate.weight":                            "weight",
+		"endpointstateheading":                            "endpoint_state_heading",
+		"endpointstateheadingkey":                         "endpoint_state_heading_key",
+		"group":                                           "group",
+		"linkattrqueries":                                 "link_attr_queries",
+		"linkattrqueries.attributes":                      "attributes",
+		"linkattrqueries.attributes.uidescription":        "ui_description",
+		"linkattrqueries.attributes.uidescriptionkey":     "ui_description_key",
+		"linkattrqueries.attributes.uiname":               "ui_name",
+		"linkattrqueries.attributes.uinamekey":            "ui_name_key",
+		"linkattrqueries.query":                           "query",
+		"linkstate":                                       "link_state",
+		"linkstate.color":                                 "color",
+		"linkstate.uidescription":                         "ui_description",
+		"linkstate.uidescriptionkey":                      "ui_description_key",
+		"linkstate.uiname":                                "ui_name",
+		"linkstate.uinamekey":                             "ui_name_key",
+		"linkstate.value":                                 "value",
+		"linkstate.weight":                                "weight",
+		"linkstateheading":                                "link_state_heading",
+		"linkstateheadingkey":                             "link_state_heading_key",
+		"name":                                            "name",
+		"nodeattrqueries":                                 "node_attr_queries",
+		"nodeattrqueries.attributes":                      "attributes",
+		"nodeattrqueries.attributes.uidescription":        "ui_description",
+		"nodeattrqueries.attributes.uidescriptionkey":     "ui_description_key",
+		"nodeattrqueries.attributes.uiname":               "ui_name",
+		"nodeattrqueries.attributes.uinamekey":            "ui_name_key",
+		"nodeattrqueries.query":                           "query",
+		"nodebadge":                                       "node_badge",
+		"nodebadge.badgename":                             "badge_name",
+		"nodebadge.badgepath":                             "badge_path",
+		"nodebadge.color":                                 "color",
+		"nodebadge.navtarget":                             "nav_target",
+		"nodebadge.uidescription":                         "ui_description",
+		"nodebadge.uidescriptionkey":                      "ui_description_key",
+		"nodebadge.uiname":                                "ui_name",
+		"nodebadge.uinamekey":                             "ui_name_key",
+		"nodebadge.value":                                 "value",
+		"nodebadge.weight":                                "weight",
+		"nodestate":                                       "node_state",
+		"nodestate.color":                                 "color",
+		"nodestate.uidescription":                         "ui_description",
+		"nodestate.uidescriptionkey":                      "ui_description_key",
+		"nodestate.uiname":                                "ui_name",
+		"nodestate.uinamekey":                             "ui_name_key",
+		"nodestate.value":                                 "value",
+		"nodestate.weight":                                "weight",
+		"nodestateheading":                                "node_state_heading",
+		"nodestateheadingkey":                             "node_state_heading_key",
+		"uidescription":                                   "ui_description",
+		"uidescriptionkey":                                "ui_description_key",
+		"uiname":                                          "ui_name",
+		"uinamekey":                                       "ui_name_key",
+		"version":                                         "version",
+	},
+	"POST /core/topology/v1/{topologyName}/state": {
+		"filter":                                                         "filter",
+		"filter.expandalllinkgroups":                                     "expand_all_link_groups",
+		"filter.expandallnodegroups":                                     "expand_all_node_groups",
+		"filter.expandedlinkgroups":                                      "expanded_link_groups",
+		"filter.expandednodegroups":                                      "expanded_node_groups",
+		"filter.linkfilter":                                              "link_filter",
+		"filter.linkfilter.endpointexpression":                           "endpoint_expression",
+		"filter.linkfilter.endpointexpression.endpoint":                  "endpoint",
+		"filter.linkfilter.endpointexpression.expression":                "expression",
+		"filter.linkfilter.fixedexpression":                              "fixed_expression",
+		"filter.linkfilter.fixedexpression.type":                         "type",
+		"filter.linkfilter.hasbadge":                                     "has_badge",
+		"filter.linkfilter.hasbadge.overlay":                             "overlay",
+		"filter.linkfilter.hasbadge.value":                               "value",
+		"filter.linkfilter.label":                                        "label",
+		"filter.linkfilter.label.selector":                               "selector",
+		"filter.linkfilter.literal":                                      "literal",
+		"filter.linkfilter.literal.value":                                "value",
+		"filter.linkfilter.logical":                                      "logical",
+		"filter.linkfilter.logical.left":                                 "left",
+		"filter.linkfilter.logical.operation":                            "operation",
+		"filter.linkfilter.logical.right":                                "right",
+		"filter.linkfilter.not":                                          "not",
+		"filter.linkfilter.valueexpression":                              "value_expression",
+		"filter.linkfilter.valueexpression.left":                         "left",
+		"filter.linkfilter.valueexpression.left.attribute":               "attribute",
+		"filter.linkfilter.valueexpression.left.attribute.name":          "name",
+		"filter.linkfilter.valueexpression.left.badge":                   "badge",
+		"filter.linkfilter.valueexpression.left.badge.overlay":           "overlay",
+		"filter.linkfilter.valueexpression.left.field":                   "field",
+		"filter.linkfilter.valueexpression.left.field.type":              "type",
+		"filter.linkfilter.valueexpression.left.literal":                 "literal",
+		"filter.linkfilter.valueexpression.left.literal.badge":           "badge",
+		"filter.linkfilter.valueexpression.left.literal.badge.overlay":   "overlay",
+		"filter.linkfilter.valueexpression.left.literal.badge.value":     "value",
+		"filter.linkfilter.valueexpression.left.literal.bool":            "bool",
+		"filter.linkfilter.valueexpression.left.literal.number":          "number",
+		"filter.linkfilter.valueexpression.left.literal.status":          "status",
+		"filter.linkfilter.valueexpression.left.literal.status.overlay":  "overlay",
+		"filter.linkfilter.valueexpression.left.literal.status.value":    "value",
+		"filter.linkfilter.valueexpression.left.literal.string":          "string",
+		"filter.linkfilter.valueexpression.left.status":                  "status",
+		"filter.linkfilter.valueexpression.left.status.overlay":          "overlay",
+		"filter.linkfilter.valueexpression.operation":                    "operation",
+		"filter.linkfilter.valueexpression.right":                        "right",
+		"filter.linkfilter.valueexpression.right.attribute":              "attribute",
+		"filter.linkfilter.valueexpression.right.attribute.name":         "name",
+		"filter.linkfilter.valueexpression.right.badge":                  "badge",
+		"filter.linkfilter.valueexpression.right.badge.overlay":          "overlay",
+		"filter.linkfilter.valueexpression.right.field":                  "field",
+		"filter.linkfilter.valueexpression.right.field.type":             "type",
+		"filter.linkfilter.valueexpression.right.literal":                "literal",
+		"filter.linkfilter.valueexpression.right.literal.badge":          "badge",
+		"filter.linkfilter.valueexpression.right.literal.badge.overlay":  "overlay",
+		"filter.linkfilter.valueexpression.right.literal.badge.value":    "value",
+		"filter.linkfilter.valueexpression.right.literal.bool":           "bool",
+		"filter.linkfilter.valueexpression.right.literal.number":         "number",
+		"filter.linkfilter.valueexpression.right.literal.status":         "status",
+		"filter.linkfilter.valueexpression.right.literal.status.overlay": "overlay",
+		"filter.linkfilter.valueexpression.right.literal.status.value":   "value",
+		"filter.linkfilter.valueexpression.right.literal.string":         "string",
+		"filter.linkfilter.valueexpression.right.status":                 "status",
+		"filter.linkfilter.valueexpression.right.status.overlay":         "overlay",
+		"filter.nodefilter":                                              "node_filter",
+		"filter.nodefilter.endpointexpression":                           "endpoint_expression",
+		"filter.nodefilter.endpointexpression.endpoint":                  "endpoint",
+		"filter.nodefilter.endpointexpression.expression":                "expression",
+		"filter.nodefilter.fixedexpression":                              "fixed_expression",
+		"filter.nodefilter.fixedexpression.type":                         "type",
+		"filter.nodefilter.hasbadge":                                     "has_badge",
+		"filter.nodefilter.hasbadge.overlay":                             "overlay",
+		"filter.nodefilter.hasbadge.value":                               "value",
+		"filter.nodefilter.label":                                        "label",
+		"filter.nodefilter.label.selector":                               "selector",
+		"filter.nodefilter.literal":                                      "literal",
+		"filter.nodefilter.literal.value":                                "value",
+		"filter.nodefilter.logical":                                      "logical",
+		"filter.nodefilter.logical.left":                                 "left",
+		"filter.nodefilter.logical.operation":                            "operation",
+		"filter.nodefilter.logical.right":                                "right",
+		"filter.nodefilter.not":                                          "not",
+		"filter.nodefilter.valueexpression":                              "value_expression",
+		"filter.nodefilter.valueexpression.left":                         "left",
+		"filter.nodefilter.valueexpression.left.attribute":               "attribute",
+		"filter.nodefilter.valueexpression.left.attribute.name":          "name",
+		"filter.nodefilter.valueexpression.left.badge":                   "badge",
+		"filter.nodefilter.valueexpression.left.badge.overlay":           "overlay",
+		"filter.nodefilter.valueexpression.left.field":                   "field",
+		"filter.nodefilter.valueexpression.left.field.type":              "type",
+		"filter.nodefilter.valueexpression.left.literal":                 "literal",
+		"filter.nodefilter.valueexpression.left.literal.badge":           "badge",
+		"filter.nodefilter.valueexpression.left.literal.badge.overlay":   "overlay",
+		"filter.nodefilter.valueexpression.left.literal.badge.value":     "value",
+		"filter.nodefilter.valueexpression.left.literal.bool":            "bool",
+		"filter.nodefilter.valueexpression.left.literal.number":          "number",
+		"filter.nodefilter.valueexpression.left.literal.status":          "status",
+		"filter.nodefilter.valueexpression.left.literal.status.overlay":  "overlay",
+		"filter.nodefilter.valueexpression.left.literal.status.value":    "value",
+		"filter.nodefilter.valueexpression.left.literal.string":          "string",
+		"filter.nodefilter.valueexpression.left.status":                  "status",
+		"filter.nodefilter.valueexpression.left.status.overlay":          "overlay",
+		"filter.nodefilter.valueexpression.operation":                    "operation",
+		"filter.nodefilter.valueexpression.right":                        "right",
+		"filter.nodefilter.valueexpression.right.attribute":              "attribute",
+		"filter.nodefilter.valueexpression.right.attribute.name":         "name",
+		"filter.nodefilter.valueexpression.right.badge":                  "badge",
+		"filter.nodefilter.valueexpression.right.badge.overlay":          "overlay",
+		"filter.nodefilter.valueexpression.right.field":                  "field",
+		"filter.nodefilter.valueexpression.right.field.type":             "type",
+		"filter.nodefilter.valueexpression.right.literal":                "literal",
+		"filter.nodefilter.valueexpression.right.literal.badge":          "badge",
+		"filter.nodefilter.valueexpression.right.literal.badge.overlay":  "overlay",
+		"filter.nodefilter.valueexpression.right.literal.badge.value":    "value",
+		"filter.nodefilter.valueexpression.right.literal.bool":           "bool",
+		"filter.nodefilter.valueexpression.right.literal.number":         "number",
+		"filter.nodefilter.valueexpression.right.literal.status":         "status",
+		"filter.nodefilter.valueexpression.right.literal.status.overlay": "overlay",
+		"filter.nodefilter.valueexpression.right.literal.status.value":   "value",
+		"filter.nodefilter.valueexpression.right.literal.string":         "string",
+		"filter.nodefilter.valueexpression.right.status":                 "status",
+		"filter.nodefilter.valueexpression.right.status.overlay":         "overlay",
+		"grouping":                                  "grouping",
+		"grouping.name":                             "name",
+		"grouping.spec":                             "spec",
+		"grouping.spec.groupselectors":              "groupSelectors",
+		"grouping.spec.groupselectors.group":        "group",
+		"grouping.spec.groupselectors.groupuiname":  "groupUIName",
+		"grouping.spec.groupselectors.nodeselector": "nodeSelector",
+		"grouping.spec.tierselectors":               "tierSelectors",
+		"grouping.spec.tierselectors.nodeselector":  "nodeSelector",
+		"grouping.spec.tierselectors.tier":          "tier",
+		"grouping.spec.uidescription":               "uiDescription",
+		"grouping.spec.uidescriptionkey":            "uiDescriptionKey",
+		"grouping.spec.uiname":                      "uiName",
+		"grouping.spec.uinamekey":                   "uiNameKey",
+		"linkgroups":                                "link_groups",
+		"linkgroups.endpointa":                      "endpoint_a",
+		"linkgroups.endpointa.groupkey":             "group_key",
+		"linkgroups.endpointa.nodekey":              "node_key",
+		"linkgroups.endpointb":                      "endpoint_b",
+		"linkgroups.endpointb.groupkey":             "group_key",
+		"linkgroups.endpointb.nodekey":              "node_key",
+		"linkgroups.key":                            "key",
+		"linkgroups.overlays":                       "overlays",
+		"linkgroups.overlays.state":                 "state",
+		"links":                                     "links",
+		"links.attributes":                          "attributes",
+		"links.crname":                              "cr_name",
+		"links.endpointa":                           "endpoint_a",
+		"links.endpointa.attributes":                "attributes",
+		"links.endpointa.crname":                    "cr_name",
+		"links.endpointa.labels":                    "labels",
+		"links.endpointa.name":                      "name",
+		"links.endpointa.namespace":                 "namespace",
+		"links.endpointa.node":                      "node",
+		"links.endpointa.nodekey":                   "node_key",
+		"links.endpointa.overlays":                  "overlays",
+		"links.endpointa.overlays.state":            "state",
+		"links.endpointa.schema":                    "schema",
+		"links.endpointa.schema.group":              "group",
+		"links.endpointa.schema.kind":               "kind",
+		"links.endpointa.schema.version":            "version",
+		"links.endpointa.uiname":                    "ui_name",
+		"links.endpointaname":                       "endpoint_a_name",
+		"links.endpointb":                           "endpoint_b",
+		"links.endpointb.attributes":                "attributes",
+		"links.endpointb.crname":                    "cr_name",
+		"links.endpointb.labels":                    "labels",
+		"links.endpointb.name":                      "name",
+		"links.endpointb.namespace":                 "namespace",
+		"links.endpointb.node":                      "node",
+		"links.endpointb.nodekey":                   "node_key",
+		"links.endpointb.overlays":                  "overlays",
+		"links.endpointb.overlays.state":            "state",
+		"links.endpointb.schema":                    "schema",
+		"links.endpointb.schema.group":              "group",
+		"links.endpointb.schema.kind":               "kind",
+		"links.endpointb.schema.version":            "version",
+		"links.endpointb.uiname":                    "ui_name",
+		"links.endpointbname":                       "endpoint_b_name",
+		"links.groupkey":                            "group_key",
+		"links.key":                                 "key",
+		"links.labels":                              "labels",
+		"links.name":                                "name",
+		"links.namespace":                           "namespace",
+		"links.overlays":                            "overlays",
+		"links.overlays.state":                      "state",
+		"links.schema":                              "schema",
+		"links.schema.group":                        "group",
+		"links.schema.kind":                         "kind",
+		"links.schema.version":                      "version",
+		"links.uiname":                              "ui_name",
+		"namespace":                                 "namespace",
+		"nodes":                                     "nodes",
+		"nodes.attributes":                          "attributes",
+		"nodes.crname":                              "cr_name",
+		"nodes.grouping":                            "grouping",
+		"nodes.grouping.group":                      "group",
+		"nodes.grouping.groupkey":                   "group_key",
+		"nodes.grouping.groupuiname":                "group_ui_name",
+		"nodes.grouping.tier":                       "tier",
+		"nodes.key":                                 "key",
+		"nodes.labels":                              "labels",
+		"nodes.name":                                "name",
+		"nodes.namespace":                           "namespace",
+		"nodes.overlays":                            "overlays",
+		"nodes.overlays.badges":                     "badges",
+		"nodes.overlays.state":                      "state",
+		"nodes.schema":                              "schema",
+		"nodes.schema.group":                        "group",
+		"nodes.schema.kind":                         "kind",
+		"nodes.schema.version":                      "version",
+		"nodes.uiname":                              "ui_name",
+		"nodesandgroups":                            "nodes_and_groups",
+		"nodesandgroups.attributes":                 "attributes",
+		"nodesandgroups.crname":                     "cr_name",
+		"nodesandgroups.grouping":                   "grouping",
+		"nodesandgroups.grouping.group":             "group",
+		"nodesandgroups.grouping.groupkey":          "group_key",
+		"nodesandgroups.grouping.groupuiname":       "group_ui_name",
+		"nodesandgroups.grouping.tier":              "tier",
+		"nodesandgroups.key":                        "key",
+		"nodesandgroups.labels":                     "labels",
+		"nodesandgroups.name":                       "name",
+		"nodesandgroups.namespace":                  "namespace",
+		"nodesandgroups.numnodes":                   "num_nodes",
+		"nodesandgroups.overlays":                   "overlays",
+		"nodesandgroups.overlays.badges":            "badges",
+		"nodesandgroups.overlays.state":             "state",
+		"nodesandgroups.schema":                     "schema",
+		"nodesandgroups.schema.group":               "group",
+		"nodesandgroups.schema.kind":                "kind",
+		"nodesandgroups.schema.version":             "version",
+		"nodesandgroups.type":                       "type",
+		"nodesandgroups.uiname":                     "ui_name",
+		"overlays":                                  "overlays",
+	},
+	"POST /core/transaction/v2": {
+		"crs":                                         "crs",
+		"crs.prior":                                   "prior",
+		"crs.prior.cr":                                "cr",
+		"crs.prior.cr.apiversion":                     "apiVersion",
+		"crs.prior.cr.kind":                           "kind",
+		"crs.prior.cr.metadata":                       "metadata",
+		"crs.prior.cr.metadata.annotations":           "annotations",
+		"crs.prior.cr.metadata.labels":                "labels",
+		"crs.prior.cr.metadata.name":                  "name",
+		"crs.prior.cr.metadata.namespace":             "namespace",
+		"crs.prior.cr.spec":                           "spec",
+		"crs.prior.notpresent":                        "notPresent",
+		"crs.type":                                    "type",
+		"crs.type.create":                             "create",
+		"crs.type.create.value":                       "value",
+		"crs.type.create.value.apiversion":            "apiVersion",
+		"crs.type.create.value.kind":                  "kind",
+		"crs.type.create.value.metadata":              "metadata",
+		"crs.type.create.value.metadata.annotations":  "annotations",
+		"crs.type.create.value.metadata.labels":       "labels",
+		"crs.type.create.value.metadata.name":         "name",
+		"crs.type.create.value.metadata.namespace":    "namespace",
+		"crs.type.create.value.spec":                  "spec",
+		"crs.type.delete":                             "delete",
+		"crs.type.delete.gvk":                         "gvk",
+		"crs.type.delete.gvk.group":                   "group",
+		"crs.type.delete.gvk.kind":                    "kind",
+		"crs.type.delete.gvk.version":                 "version",
+		"crs.type.delete.name":                        "name",
+		"crs.type.delete.namespace":                   "namespace",
+		"crs.type.modify":                             "modify",
+		"crs.type.modify.value":                       "value",
+		"crs.type.modify.value.apiversion":            "apiVersion",
+		"crs.type.modify.value.kind":                  "kind",
+		"crs.type.modify.value.metadata":              "metadata",
+		"crs.type.modify.value.metadata.annotations":  "annotations",
+		"crs.type.modify.value.metadata.labels":       "labels",
+		"crs.type.modify.value.metadata.name":         "name",
+		"crs.type.modify.value.metadata.namespace":    "namespace",
+		"crs.type.modify.value.spec":                  "spec",
+		"crs.type.patch":                              "patch",
+		"crs.type.patch.patchops":                     "patchOps",
+		"crs.type.patch.patchops.from":                "from",
+		"crs.type.patch.patchops.op":                  "op",
+		"crs.type.patch.patchops.path":                "path",
+		"crs.type.patch.patchops.value":               "value",
+		"crs.type.patch.patchops.xpermissive":         "x-permissive",
+		"crs.type.patch.target":                       "target",
+		"crs.type.patch.target.gvk":                   "gvk",
+		"crs.type.patch.target.gvk.group":             "group",
+		"crs.type.patch.target.gvk.kind":              "kind",
+		"crs.type.patch.target.gvk.version":           "version",
+		"crs.type.patch.target.name":                  "name",
+		"crs.type.patch.target.namespace":             "namespace",
+		"crs.type.replace":                            "replace",
+		"crs.type.replace.value":                      "value",
+		"crs.type.replace.value.apiversion":           "apiVersion",
+		"crs.type.replace.value.kind":                 "kind",
+		"crs.type.replace.value.metadata":             "metadata",
+		"crs.type.replace.value.metadata.annotations": "annotations",
+		"crs.type.replace.value.metadata.labels":      "labels",
+		"crs.type.replace.value.metadata.name":        "name",
+		"crs.type.replace.value.metadata.namespace":   "namespace",
+		"crs.type.replace.value.spec":                 "spec",
+		"description":                                 "description",
+		"detaillevel":                                 "detailLevel",
+		"disablebatching":                             "disableBatching",
+		"dryrun":                                      "dryRun",
+		"id":                                          "id",
+		"resulttype":                                  "resultType",
+		"retain":                                      "retain",
+	},
+	"POST /core/transaction/v2/restore/{transactionId}": {
+		"detaillevel": "detailLevel",
+		"dryrun":      "dryRun",
+		"id":          "id",
+	},
+	"GET /core/transaction/v2/result/diffs/nodecfg/{transactionId}": {
+		"after":           "after",
+		"after.data":      "data",
+		"before":          "before",
+		"before.data":     "data",
+		"dataunavailable": "dataUnavailable",
+		"format":          "format",
+	},
+	"GET /core/transaction/v2/result/diffs/resource/{transactionId}": {
+		"after":           "after",
+		"after.data":      "data",
+		"before":          "before",
+		"before.data":     "data",
+		"dataunavailable": "dataUnavailable",
+		"format":          "format",
+	},
+	"GET /core/transaction/v2/result/execution/{transactionId}": {
+		"changedcrs":                                     "changedCrs",
+		"changedcrs.gvk":                                 "gvk",
+		"changedcrs.gvk.group":                           "group",
+		"changedcrs.gvk.kind":                            "kind",
+		"changedcrs.gvk.version":                         "version",
+		"changedcrs.names":                               "names",
+		"changedcrs.namespace":                           "namespace",
+		"executionsummary":                               "executionSummary",
+		"generalerrors":                                  "generalErrors",
+		"intentsrun":                                     "intentsRun",
+		"intentsrun.errors":                              "errors",
+		"intentsrun.errors.error":                        "error",
+		"intentsrun.errors.error.causecollection":        "causeCollection",
+		"intentsrun.errors.error.causeindexedcollection": "causeIndexedCollection",
+		"intentsrun.errors.error.causeisinternal":        "causeIsInternal",
+		"intentsrun.errors.error.causesimple":            "causeSimple",
+		"intentsrun.errors.error.causewrapped":           "causeWrapped",
+		"intentsrun.errors.error.domain":                 "domain",
+		"intentsrun.errors.error.index":                  "index",
+		"intentsrun.errors.error.message":                "message",
+		"intentsrun.errors.error.ref":                    "ref",
+		"intentsrun.errors.error.type":                   "type",
+		"intentsrun.errors.error.values":                 "values",
+		"intentsrun.errors.rawerror":                     "rawError",
+		"intentsrun.intentname":                          "intentName",
+		"intentsrun.intentname.gvk":                      "gvk",
+		"intentsrun.intentname.gvk.group":                "group",
+		"intentsrun.intentname.gvk.kind":                 "kind",
+		"intentsrun.intentname.gvk.version":              "version",
+		"intentsrun.intentname.name":                     "name",
+		"intentsrun.intentname.namespace":                "namespace",
+		"intentsrun.outputcrs":                           "outputCrs",
+		"intentsrun.outputcrs.gvk":                       "gvk",
+		"intentsrun.outputcrs.gvk.group":                 "group",
+		"intentsrun.outputcrs.gvk.kind":                  "kind",
+		"intentsrun.outputcrs.gvk.version":               "version",
+		"intentsrun.outputcrs.name":                      "name",
+		"intentsrun.outputcrs.namespace":                 "namespace",
+		"intentsrun.script":                              "script",
+		"intentsrun.script.executiontime":                "executionTime",
+		"nodeswithconfigchanges":                         "nodesWithConfigChanges",
+		"nodeswithconfigchanges.errors":                  "errors",
+		"nodeswithconfigchanges.name":                    "name",
+		"nodeswithconfigchanges.namespace":               "namespace",
+		"topologysupported":                              "topologySupported",
+	},
+	"GET /core/transaction/v2/result/inputresources/{transactionId}": {
+		"inputcrs":                  "inputCrs",
+		"inputcrs.data":             "data",
+		"inputcrs.isdelete":         "isDelete",
+		"inputcrs.name":             "name",
+		"inputcrs.name.gvk":         "gvk",
+		"inputcrs.name.gvk.group":   "group",
+		"inputcrs.name.gvk.kind":    "kind",
+		"inputcrs.name.gvk.version": "version",
+		"inputcrs.name.name":        "name",
+		"inputcrs.name.namespace":   "namespace",
+		"inputcrs.operation":        "operation",
+		"limitedaccess":             "limitedAccess",
+	},
+	"GET /core/transaction/v2/result/summary": {
+		"results":                      "results",
+		"results.bundledtransactionid": "bundledTransactionId",
+		"results.commithash":           "commitHash",
+		"results.description":          "description",
+		"results.detaillevel":          "detailLevel",
+		"results.details":              "details",
+		"results.dryrun":               "dryRun",
+		"results.id":                   "id",
+		"results.lastchangetimestamp":  "lastChangeTimestamp",
+		"results.state":                "state",
+		"results.success":              "success",
+		"results.username":             "username",
+	},
+	"GET /core/transaction/v2/result/summary/{transactionId}": {
+		"bundledtransactionid": "bundledTransactionId",
+		"commithash":           "commitHash",
+		"description":          "description",
+		"detaillevel":          "detailLevel",
+		"details":              "details",
+		"dryrun":               "dryRun",
+		"id":                   "id",
+		"lastchangetimestamp":  "lastChangeTimestamp",
+		"state":                "state",
+		"success":              "success",
+		"username":             "username",
+	},
+	"POST /core/transaction/v2/result/topology/{transactionId}": {
+		"filter":                                                         "filter",
+		"filter.expandalllinkgroups":                                     "expand_all_link_groups",
+		"filter.expandallnodegroups":                                     "expand_all_node_groups",
+		"filter.expandedlinkgroups":                                      "expanded_link_groups",
+		"filter.expandednodegroups":                                      "expanded_node_groups",
+		"filter.linkfilter":                                              "link_filter",
+		"filter.linkfilter.endpointexpression":                           "endpoint_expression",
+		"filter.linkfilter.endpointexpression.endpoint":                  "endpoint",
+		"filter.linkfilter.endpointexpression.expression":                "expression",
+		"filter.linkfilter.fixedexpression":                              "fixed_expression",
+		"filter.linkfilter.fixedexpression.type":                         "type",
+		"filter.linkfilter.hasbadge":                                     "has_badge",
+		"filter.linkfilter.hasbadge.overlay":                             "overlay",
+		"filter.linkfilter.hasbadge.value":                               "value",
+		"filter.linkfilter.label":                                        "label",
+		"filter.linkfilter.label.selector":                               "selector",
+		"filter.linkfilter.literal":                                      "literal",
+		"filter.linkfilter.literal.value":                                "value",
+		"filter.linkfilter.logical":                                      "logical",
+		"filter.linkfilter.logical.left":                                 "left",
+		"filter.linkfilter.logical.operation":                            "operation",
+		"filter.linkfilter.logical.right":                                "right",
+		"filter.linkfilter.not":                                          "not",
+		"filter.linkfilter.valueexpression":                              "value_expression",
+		"filter.linkfilter.valueexpression.left":                         "left",
+		"filter.linkfilter.valueexpression.left.attribute":               "attribute",
+		"filter.linkfilter.valueexpression.left.attribute.name":          "name",
+		"filter.linkfilter.valueexpression.left.badge":                   "badge",
+		"filter.linkfilter.valueexpression.left.badge.overlay":           "overlay",
+		"filter.linkfilter.valueexpression.left.field":                   "field",
+		"filter.linkfilter.valueexpression.left.field.type":              "type",
+		"filter.linkfilter.valueexpression.left.literal":                 "literal",
+		"filter.linkfilter.valueexpression.left.literal.badge":           "badge",
+		"filter.linkfilter.valueexpression.left.literal.badge.overlay":   "overlay",
+		"filter.linkfilter.valueexpression.left.literal.badge.value":     "value",
+		"filter.linkfilter.valueexpression.left.literal.bool":            "bool",
+		"filter.linkfilter.valueexpression.left.literal.number":          "number",
+		"filter.linkfilter.valueexpression.left.literal.status":          "status",
+		"filter.linkfilter.valueexpression.left.literal.status.overlay":  "overlay",
+		"filter.linkfilter.valueexpression.left.literal.status.value":    "value",
+		"filter.linkfilter.valueexpression.left.literal.string":          "string",
+		"filter.linkfilter.valueexpression.left.status":                  "status",
+		"filter.linkfilter.valueexpression.left.status.overlay":          "overlay",
+		"filter.linkfilter.valueexpression.operation":                    "operation",
+		"filter.linkfilter.valueexpression.right":                        "right",
+		"filter.linkfilter.valueexpression.right.attribute":              "attribute",
+		"filter.linkfilter.valueexpression.right.attribute.name":         "name",
+		"filter.linkfilter.valueexpression.right.badge":                  "badge",
+		"filter.linkfilter.valueexpression.right.badge.overlay":          "overlay",
+		"filter.linkfilter.valueexpression.right.field":                  "field",
+		"filter.linkfilter.valueexpression.right.field.type":             "type",
+		"filter.linkfilter.valueexpression.right.literal":                "literal",
+		"filter.linkfilter.valueexpression.right.literal.badge":          "badge",
+		"filter.linkfilter.valueexpression.right.literal.badge.overlay":  "overlay",
+		"filter.linkfilter.valueexpression.right.literal.badge.value":    "value",
+		"filter.linkfilter.valueexpression.right.literal.bool":           "bool",
+		"filter.linkfilter.valueexpression.right.literal.number":         "number",
+		"filter.linkfilter.valueexpression.right.literal.status":         "status",
+		"filter.linkfilter.valueexpression.right.literal.status.overlay": "overlay",
+		"filter.linkfilter.valueexpression.right.literal.status.value":   "value",
+		"filter.linkfilter.valueexpression.right.literal.string":         "string",
+		"filter.linkfilter.valueexpression.right.status":                 "status",
+		"filter.linkfilter.valueexpression.right.status.overlay":         "overlay",
+		"filter.nodefilter":                                              "node_filter",
+		"filter.nodefilter.endpointexpression":                           "endpoint_expression",
+		"filter.nodefilter.endpointexpression.endpoint":                  "endpoint",
+		"filter.nodefilter.endpointexpression.expression":                "expression",
+		"filter.nodefilter.fixedexpression":                              "fixed_expression",
+		"filter.nodefilter.fixedexpression.type":                         "type",
+		"filter.nodefilter.hasbadge":                                     "has_badge",
+		"filter.nodefilter.hasbadge.overlay":                             "overlay",
+		"filter.nodefilter.hasbadge.value":                               "value",
+		"filter.nodefilter.label":                                        "label",
+		"filter.nodefilter.label.selector":                               "selector",
+		"filter.nodefilter.literal":                                      "literal",
+		"filter.nodefilter.literal.value":                                "value",
+		"filter.nodefilter.logical":                                      "logical",
+		"filter.nodefilter.logical.left":                                 "left",
+		"filter.nodefilter.logical.operation":                            "operation",
+		"filter.nodefilter.logical.right":                                "right",
+		"filter.nodefilter.not":                                          "not",
+		"filter.nodefilter.valueexpression":                              "value_expression",
+		"filter.nodefilter.valueexpression.left":                         "left",
+		"filter.nodefilter.valueexpression.left.attribute":               "attribute",
+		"filter.nodefilter.valueexpression.left.attribute.name":          "name",
+		"filter.nodefilter.valueexpression.left.badge":                   "badge",
+		"filter.nodefilter.valueexpression.left.badge.overlay":           "overlay",
+		"filter.nodefilter.valueexpression.left.field":                   "field",
+		"filter.nodefilter.valueexpression.left.field.type":              "type",
+		"filter.nodefilter.valueexpression.left.literal":                 "literal",
+		"filter.nodefilter.valueexpression.left.literal.badge":           "badge",
+		"filter.nodefilter.valueexpression.left.literal.badge.overlay":   "overlay",
+		"filter.nodefilter.valueexpression.left.literal.badge.value":     "value",
+		"filter.nodefilter.valueexpression.left.literal.bool":            "bool",
+		"filter.nodefilter.valueexpression.left.literal.number":          "number",
+		"filter.nodefilter.valueexpression.left.literal.status":          "status",
+		"filter.nodefilter.valueexpression.left.literal.status.overlay":  "overlay",
+		"filter.nodefilter.valueexpression.left.literal.status.value":    "value",
+		"filter.nodefilter.valueexpression.left.literal.string":          "string",
+		"filter.nodefilter.valueexpression.left.status":                  "status",
+		"filter.nodefilter.valueexpression.left.status.overlay":          "overlay",
+		"filter.nodefilter.valueexpression.operation":                    "operation",
+		"filter.nodefilter.valueexpression.right":                        "right",
+		"filter.nodefilter.valueexpression.right.attribute":              "attribute",
+		"filter.nodefilter.valueexpression.right.attribute.name":         "name",
+		"filter.nodefilter.valueexpression.right.badge":                  "badge",
+		"filter.nodefilter.valueexpression.right.badge.overlay":          "overlay",
+		"filter.nodefilter.valueexpression.right.field":                  "field",
+		"filter.nodefilter.valueexpression.right.field.type":             "type",
+		"filter.nodefilter.valueexpression.right.literal":                "literal",
+		"filter.nodefilter.valueexpression.right.literal.badge":          "badge",
+		"filter.nodefilter.valueexpression.right.literal.badge.overlay":  "overlay",
+		"filter.nodefilter.valueexpression.right.literal.badge.value":    "value",
+		"filter.nodefilter.valueexpression.right.literal.bool":           "bool",
+		"filter.nodefilter.valueexpression.right.literal.number":         "number",
+		"filter.nodefilter.valueexpression.right.literal.status":         "status",
+		"filter.nodefilter.valueexpression.right.literal.status.overlay": "overlay",
+		"filter.nodefilter.valueexpression.right.literal.status.value":   "value",
+		"filter.nodefilter.valueexpression.right.literal.string":         "string",
+		"filter.nodefilter.valueexpression.right.status":                 "status",
+		"filter.nodefilter.valueexpression.right.status.overlay":         "overlay",
+		"overlaymetadata":                                                 "overlayMetadata",
+		"overlaymetadata.endpointattrqueries":                             "endpoint_attr_queries",
+		"overlaymetadata.endpointattrqueries.attributes":                  "attributes",
+		"overlaymetadata.endpointattrqueries.attributes.uidescription":    "ui_description",
+		"overlaymetadata.endpointattrqueries.attributes.uidescriptionkey": "ui_description_key",
+		"overlaymetadata.endpointattrqueries.attributes.uiname":           "ui_name",
+		"overlaymetadata.endpointattrqueries.attributes.uinamekey":        "ui_name_key",
+		"overlaymetadata.endpointattrqueries.query":                       "query",
+		"overlaymetadata.endpointstate":                                   "endpoint_state",
+		"overlaymetadata.endpointstate.color":                             "color",
+		"overlaymetadata.endpointstate.uidescription":                     "ui_description",
+		"overlaymetadata.endpointstate.uidescriptionkey":                  "ui_description_key",
+		"overlaymetadata.endpointstate.uiname":                            "ui_name",
+		"overlaymetadata.endpointstate.uinamekey":                         "ui_name_key",
+		"overlaymetadata.endpointstate.value":                             "value",
+		"overlaymetadata.endpointstate.weight":                            "weight",
+		"overlaymetadata.endpointstateheading":                            "endpoint_state_heading",
+		"overlaymetadata.endpointstateheadingkey":                         "endpoint_state_heading_key",
+		"overlaymetadata.group":                                           "group",
+		"overlaymetadata.linkattrqueries":                                 "link_attr_queries",
+		"overlaymetadata.linkattrqueries.attributes":                      "attributes",
+		"overlaymetadata.linkattrqueries.attributes.uidescription":        "ui_description",
+		"overlaymetadata.linkattrqueries.attributes.uidescriptionkey":     "ui_description_key",
+		"overlaymetadata.linkattrqueries.attributes.uiname":               "ui_name",
+		"overlaymetadata.linkattrqueries.attributes.uinamekey":            "ui_name_key",
+		"overlaymetadata.linkattrqueries.query":                           "query",
+		"overlaymetadata.linkstate":                                       "link_state",
+		"overlaymetadata.linkstate.color":                                 "color",
+		"overlaymetadata.linkstate.uidescription":                         "ui_description",
+		"overlaymetadata.linkstate.uidescriptionkey":                      "ui_description_key",
+		"overlaymetadata.linkstate.uiname":                                "ui_name",
+		"overlaymetadata.linkstate.uinamekey":                             "ui_name_key",
+		"overlaymetadata.linkstate.value":                                 "value",
+		"overlaymetadata.linkstate.weight":                                "weight",
+		"overlaymetadata.linkstateheading":                                "link_state_heading",
+		"overlaymetadata.linkstateheadingkey":                             "link_state_heading_key",
+		"overlaymetadata.name":                                            "name",
+		"overlaymetadata.nodeattrqueries":                                 "node_attr_queries",
+		"overlaymetadata.nodeattrqueries.attributes":                      "attributes",
+		"overlaymetadata.nodeattrqueries.attributes.uidescription":        "ui_description",
+		"overlaymetadata.nodeattrqueries.attributes.uidescriptionkey":     "ui_description_key",
+		"overlaymetadata.nodeattrqueries.attributes.uiname":               "ui_name",
+		"overlaymetadata.nodeattrqueries.attributes.uinamekey":            "ui_name_key",
+		"overlaymetadata.nodeattrqueries.query":                           "query",
+		"overlaymetadata.nodebadge":                                       "node_badge",
+		"overlaymetadata.nodebadge.badgename":                             "badge_name",
+		"overlaymetadata.nodebadge.badgepath":                             "badge_path",
+		"overlaymetadata.nodebadge.color":                                 "color",
+		"overlaymetadata.nodebadge.navtarget":                             "nav_target",
+		"overlaymetadata.nodebadge.uidescription":                         "ui_description",
+		"overlaymetadata.nodebadge.uidescriptionkey":                      "ui_description_key",
+		"overlaymetadata.nodebadge.uiname":                                "ui_name",
+		"overlaymetadata.nodebadge.uinamekey":                             "ui_name_key",
+		"overlaymetadata.nodebadge.value":                                 "value",
+		"overlaymetadata.nodebadge.weight":                                "weight",
+		"overlaymetadata.nodestate":                                       "node_state",
+		"overlaymetadata.nodestate.color":                                 "color",
+		"overlaymetadata.nodestate.uidescription":                         "ui_description",
+		"overlaymetadata.nodestate.uidescriptionkey":                      "ui_description_key",
+		"overlaymetadata.nodestate.uiname":                                "ui_name",
+		"overlaymetadata.nodestate.uinamekey":                             "ui_name_key",
+		"overlaymetadata.nodestate.value":                                 "value",
+		"overlaymetadata.nodestate.weight":                                "weight",
+		"overlaymetadata.nodestateheading":                                "node_state_heading",
+		"overlaymetadata.nodestateheadingkey":                             "node_state_heading_key",
+		"overlaymetadata.uidescription":                                   "ui_description",
+		"overlaymetadata.uidescriptionkey":                                "ui_description_key",
+		"overlaymetadata.uiname":                                          "ui_name",
+		"overlaymetadata.uinamekey":                                       "ui_name_key",
+		"overlaymetadata.version":                                         "version",
+		"topology":                                                        "topology",
+		"topology.linkgroups":                                             "link_groups",
+		"topology.linkgroups.endpointa":                                   "endpoint_a",
+		"topology.linkgroups.endpointa.groupkey":                          "group_key",
+		"topology.linkgroups.endpointa.nodekey":                           "node_key",
+		"topology.linkgroups.endpointb":                                   "endpoint_b",
+		"topology.linkgroups.endpointb.groupkey":                          "group_key",
+		"topology.linkgroups.endpointb.nodekey":                           "node_key",
+		"topology.linkgroups.key":                                         "key",
+		"topology.linkgroups.overlays":                                    "overlays",
+		"topology.linkgroups.overlays.state":                              "state",
+		"topology.links":                                                  "links",
+		"topology.links.attributes":                                       "attributes",
+		"topology.links.crname":                                           "cr_name",
+		"topology.links.endpointa":                                        "endpoint_a",
+		"topology.links.endpointa.attributes":                             "attributes",
+		"topology.links.endpointa.crname":                                 "cr_name",
+		"topology.links.endpointa.labels":                                 "labels",
+		"topology.links.endpointa.name":                                   "name",
+		"topology.links.endpointa.namespace":                              "namespace",
+		"topology.links.endpointa.node":                                   "node",
+		"topology.links.endpointa.nodekey":                                "node_key",
+		"topology.links.endpointa.overlays":                               "overlays",
+		"topology.links.endpointa.overlays.state":                         "state",
+		"topology.links.endpointa.schema":                                 "schema",
+		"topology.links.endpointa.schema.group":                           "group",
+		"topology.links.endpointa.schema.kind":                            "kind",
+		"topology.links.endpointa.schema.version":                         "version",
+		"topology.links.endpointa.uiname":                                 "ui_name",
+		"topology.links.endpointaname":                                    "endpoint_a_name",
+		"topology.links.endpointb":                                        "endpoint_b",
+		"topology.links.endpointb.attributes":                             "attributes",
+		"topology.links.endpointb.crname":                                 "cr_name",
+		"topology.links.endpointb.labels":                                 "labels",
+		"topology.links.endpointb.name":                                   "name",
+		"topology.links.endpointb.namespace":                              "namespace",
+		"topology.links.endpointb.node":                                   "node",
+		"topology.links.endpointb.nodekey":                                "node_key",
+		"topology.links.endpointb.overlays":                               "overlays",
+		"topology.links.endpointb.overlays.state":                         "state",
+		"topology.links.endpointb.schema":                                 "schema",
+		"topology.links.endpointb.schema.group":                           "group",
+		"topology.links.endpointb.schema.kind":                            "kind",
+		"topology.links.endpointb.schema.version":                         "version",
+		"topology.links.endpointb.uiname":                                 "ui_name",
+		"topology.links.endpointbname":                                    "endpoint_b_name",
+		"topology.links.groupkey":                                         "group_key",
+		"topology.links.key":                                              "key",
+		"topology.links.labels":                                           "labels",
+		"topology.links.name":                                             "name",
+		"topology.links.namespace":                                        "namespace",
+		"topology.links.overlays":                                         "overlays",
+		"topology.links.overlays.state":                                   "state",
+		"topology.links.schema":                                           "schema",
+		"topology.links.schema.group":                                     "group",
+		"topology.links.schema.kind":                                      "kind",
+		"topology.links.schema.version":                                   "version",
+		"topology.links.uiname":                                           "ui_name",
+		"topology.nodes":                                                  "nodes",
+		"topology.nodes.attributes":                                       "attributes",
+		"topology.nodes.crname":                                           "cr_name",
+		"topology.nodes.grouping":                                         "grouping",
+		"topology.nodes.grouping.group":                                   "group",
+		"topology.nodes.grouping.groupkey":                                "group_key",
+		"topology.nodes.grouping.groupuiname":                             "group_ui_name",
+		"topology.nodes.grouping.tier":                                    "tier",
+		"topology.nodes.key":                                              "key",
+		"topology.nodes.labels":                                           "labels",
+		"topology.nodes.name":                                             "name",
+		"topology.nodes.namespace":                                        "namespace",
+		"topology.nodes.overlays":                                         "overlays",
+		"topology.nodes.overlays.badges":                                  "badges",
+		"topology.nodes.overlays.state":                                   "state",
+		"topology.nodes.schema":                                           "schema",
+		"topology.nodes.schema.group":                                     "group",
+		"topology.nodes.schema.kind":                                      "kind",
+		"topology.nodes.schema.version":                                   "version",
+		"topology.nodes.uiname":                                           "ui_name",
+		"topology.nodesandgroups":                                         "nodes_and_groups",
+		"topology.nodesandgroups.attributes":                              "attributes",
+		"topology.nodesandgroups.crname":                                  "cr_name",
+		"topology.nodesandgroups.grouping":                                "grouping",
+		"topology.nodesandgroups.grouping.group":                          "group",
+		"topology.nodesandgroups.grouping.groupkey":                       "group_key",
+		"topology.nodesandgroups.grouping.groupuiname":                    "group_ui_name",
+		"topology.nodesandgroups.grouping.tier":                           "tier",
+		"topology.nodesandgroups.key":                                     "key",
+		"topology.nodesandgroups.labels":                                  "labels",
+		"topology.nodesandgroups.name":                                    "name",
+		"topology.nodesandgroups.namespace":                               "namespace",
+		"topology.nodesandgroups.numnodes":                                "num_nodes",
+		"topology.nodesandgroups.overlays":                                "overlays",
+		"topology.nodesandgroups.overlays.badges":                         "badges",
+		"topology.nodesandgroups.overlays.state":                          "state",
+		"topology.nodesandgroups.schema":                                  "schema",
+		"topology.nodesandgroups.schema.group":                            "group",
+		"topology.nodesandgroups.schema.kind":                             "kind",
+		"topology.nodesandgroups.schema.version":                          "version",
+		"topology.nodesandgroups.type":                                    "type",
+		"topology.nodesandgroups.uiname":                                  "ui_name",
+		"topologymetadata":                                                "topologyMetadata",
+		"topologymetadata.endpoints":                                      "endpoints",
+		"topologymetadata.endpoints.attributes":                           "attributes",
+		"topologymetadata.endpoints.attributes.type":                      "type",
+		"topologymetadata.endpoints.attributes.uidescription":             "ui_description",
+		"topologymetadata.endpoints.attributes.uidescriptionkey":          "ui_description_key",
+		"topologymetadata.endpoints.attributes.uiname":                    "ui_name",
+		"topologymetadata.endpoints.attributes.uinamekey":                 "ui_name_key",
+		"topologymetadata.endpoints.schema":                               "schema",
+		"topologymetadata.endpoints.schema.group":                         "group",
+		"topologymetadata.endpoints.schema.kind":                          "kind",
+		"topologymetadata.endpoints.schema.version":                       "version",
+		"topologymetadata.endpoints.subtitle":                             "subtitle",
+		"topologymetadata.endpoints.subtitlekey":                          "subtitle_key",
+		"topologymetadata.group":                                          "group",
+		"topologymetadata.grouping":                                       "grouping",
+		"topologymetadata.grouping.group":                                 "group",
+		"topologymetadata.grouping.kind":                                  "kind",
+		"topologymetadata.grouping.version":                               "version",
+		"topologymetadata.links":                                          "links",
+		"topologymetadata.links.attributes":                               "attributes",
+		"topologymetadata.links.attributes.type":                          "type",
+		"topologymetadata.links.attributes.uidescription":                 "ui_description",
+		"topologymetadata.links.attributes.uidescriptionkey":              "ui_description_key",
+		"topologymetadata.links.attributes.uiname":                        "ui_name",
+		"topologymetadata.links.attributes.uinamekey":                     "ui_name_key",
+		"topologymetadata.links.schema":                                   "schema",
+		"topologymetadata.links.schema.group":                             "group",
+		"topologymetadata.links.schema.kind":                              "kind",
+		"topologymetadata.links.schema.version":                           "version",
+		"topologymetadata.links.subtitle":                                 "subtitle",
+		"topologymetadata.links.subtitlekey":                              "subtitle_key",
+		"topologymetadata.name":                                           "name",
+		"topologymetadata.nodes":                                          "nodes",
+		"topologymetadata.nodes.attributes":                               "attributes",
+		"topologymetadata.nodes.attributes.type":                          "type",
+		"topologymetadata.nodes.attributes.uidescription":                 "ui_description",
+		"topologymetadata.nodes.attributes.uidescriptionkey":              "ui_description_key",
+		"topologymetadata.nodes.attributes.uiname":                        "ui_name",
+		"topologymetadata.nodes.attributes.uinamekey":                     "ui_name_key",
+		"topologymetadata.nodes.schema":                                   "schema",
+		"topologymetadata.nodes.schema.group":                             "group",
+		"topologymetadata.nodes.schema.kind":                              "kind",
+		"topologymetadata.nodes.schema.version":                           "version",
+		"topologymetadata.nodes.subtitle":                                 "subtitle",
+		"topologymetadata.nodes.subtitlekey":                              "subtitle_key",
+		"topologymetadata.uidescription":                                  "ui_description",
+		"topologymetadata.uidescriptionkey":                               "ui_description_key",
+		"topologymetadata.uiname":                                         "ui_name",
+		"topologymetadata.uinamekey":                                      "ui_name_key",
+		"topologymetadata.version":                                        "version",
+	},
+	"POST /core/transaction/v2/revert/{transactionId}": {
+		"detaillevel": "detailLevel",
+		"dryrun":      "dryRun",
+		"id":          "id",
+	},
+	"GET /core/transaction/v2/state/{transactionId}": {
+		"state": "state",
+	},
+	"POST /core/transaction/v2/validate": {
+		"prior":                                   "prior",
+		"prior.cr":                                "cr",
+		"prior.cr.apiversion":                     "apiVersion",
+		"prior.cr.kind":                           "kind",
+		"prior.cr.metadata":                       "metadata",
+		"prior.cr.metadata.annotations":           "annotations",
+		"prior.cr.metadata.labels":                "labels",
+		"prior.cr.metadata.name":                  "name",
+		"prior.cr.metadata.namespace":             "namespace",
+		"prior.cr.spec":                           "spec",
+		"prior.notpresent":                        "notPresent",
+		"type":                                    "type",
+		"type.create":                             "create",
+		"type.create.value":                       "value",
+		"type.create.value.apiversion":            "apiVersion",
+		"type.create.value.kind":                  "kind",
+		"type.create.value.metadata":              "metadata",
+		"type.create.value.metadata.annotations":  "annotations",
+		"type.create.value.metadata.labels":       "labels",
+		"type.create.value.metadata.name":         "name",
+		"type.create.value.metadata.namespace":    "namespace",
+		"type.create.value.spec":                  "spec",
+		"type.delete":                             "delete",
+		"type.delete.gvk":                         "gvk",
+		"type.delete.gvk.group":                   "group",
+		"type.delete.gvk.kind":                    "kind",
+		"type.delete.gvk.version":                 "version",
+		"type.delete.name":                        "name",
+		"type.delete.namespace":                   "namespace",
+		"type.modify":                             "modify",
+		"type.modify.value":                       "value",
+		"type.modify.value.apiversion":            "apiVersion",
+		"type.modify.value.kind":                  "kind",
+		"type.modify.value.metadata":              "metadata",
+		"type.modify.value.metadata.annotations":  "annotations",
+		"type.modify.value.metadata.labels":       "labels",
+		"type.modify.value.metadata.name":         "name",
+		"type.modify.value.metadata.namespace":    "namespace",
+		"type.modify.value.spec":                  "spec",
+		"type.patch":                              "patch",
+		"type.patch.patchops":                     "patchOps",
+		"type.patch.patchops.from":                "from",
+		"type.patch.patchops.op":                  "op",
+		"type.patch.patchops.path":                "path",
+		"type.patch.patchops.value":               "value",
+		"type.patch.patchops.xpermissive":         "x-permissive",
+		"type.patch.target":                       "target",
+		"type.patch.target.gvk":                   "gvk",
+		"type.patch.target.gvk.group":             "group",
+		"type.patch.target.gvk.kind":              "kind",
+		"type.patch.target.gvk.version":           "version",
+		"type.patch.target.name":                  "name",
+		"type.patch.target.namespace":             "namespace",
+		"type.replace":                            "replace",
+		"type.replace.value":                      "value",
+		"type.replace.value.apiversion":           "apiVersion",
+		"type.replace.value.kind":                 "kind",
+		"type.replace.value.metadata":             "metadata",
+		"type.replace.value.metadata.annotations": "annotations",
+		"type.replace.value.metadata.labels":      "labels",
+		"type.replace.value.metadata.name":        "name",
+		"type.replace.value.metadata.namespace":   "namespace",
+		"type.replace.value.spec":                 "spec",
+	},
+	"POST /core/transaction/v3/rebase": {
+		"crs":                                                          "crs",
+		"crs.prior":                                                    "prior",
+		"crs.prior.cr":                                                 "cr",
+		"crs.prior.cr.apiversion":                                      "apiVersion",
+		"crs.prior.cr.kind":                                            "kind",
+		"crs.prior.cr.metadata":                                        "metadata",
+		"crs.prior.cr.metadata.annotations":                            "annotations",
+		"crs.prior.cr.metadata.labels":                                 "labels",
+		"crs.prior.cr.metadata.name":                                   "name",
+		"crs.prior.cr.metadata.namespace":                              "namespace",
+		"crs.prior.cr.spec":                                            "spec",
+		"crs.prior.notpresent":                                         "notPresent",
+		"crs.type":                                                     "type",
+		"crs.type.create":                                              "create",
+		"crs.type.create.value":                                        "value",
+		"crs.type.create.value.apiversion":                             "apiVersion",
+		"crs.type.create.value.kind":                                   "kind",
+		"crs.type.create.value.metadata":                               "metadata",
+		"crs.type.create.value.metadata.annotations":                   "annotations",
+		"crs.type.create.value.metadata.labels":                        "labels",
+		"crs.type.create.value.metadata.name":                          "name",
+		"crs.type.create.value.metadata.namespace":                     "namespace",
+		"crs.type.create.value.spec":                                   "spec",
+		"crs.type.delete":                                              "delete",
+		"crs.type.delete.gvk":                                          "gvk",
+		"crs.type.delete.gvk.group":                                    "group",
+		"crs.type.delete.gvk.kind":                                     "kind",
+		"crs.type.delete.gvk.version":                                  "version",
+		"crs.type.delete.name":                                         "name",
+		"crs.type.delete.namespace":                                    "namespace",
+		"crs.type.modify":                                              "modify",
+		"crs.type.modify.value":                                        "value",
+		"crs.type.modify.value.apiversion":                             "apiVersion",
+		"crs.type.modify.value.kind":                                   "kind",
+		"crs.type.modify.value.metadata":                               "metadata",
+		"crs.type.modify.value.metadata.annotations":                   "annotations",
+		"crs.type.modify.value.metadata.labels":                        "labels",
+		"crs.type.modify.value.metadata.name":                          "name",
+		"crs.type.modify.value.metadata.namespace":                     "namespace",
+		"crs.type.modify.value.spec":                                   "spec",
+		"crs.type.patch":                                               "patch",
+		"crs.type.patch.patchops":                                      "patchOps",
+		"crs.type.patch.patchops.from":                                 "from",
+		"crs.type.patch.patchops.op":                                   "op",
+		"crs.type.patch.patchops.path":                                 "path",
+		"crs.type.patch.patchops.value":                                "value",
+		"crs.type.patch.patchops.xpermissive":                          "x-permissive",
+		"crs.type.patch.target":                                        "target",
+		"crs.type.patch.target.gvk":                                    "gvk",
+		"crs.type.patch.target.gvk.group":                              "group",
+		"crs.type.patch.target.gvk.kind":                               "kind",
+		"crs.type.patch.target.gvk.version":                            "version",
+		"crs.type.patch.target.name":                                   "name",
+		"crs.type.patch.target.namespace":                              "namespace",
+		"crs.type.replace":                                             "replace",
+		"crs.type.replace.value":                                       "value",
+		"crs.type.replace.value.apiversion":                            "apiVersion",
+		"crs.type.replace.value.kind":                                  "kind",
+		"crs.type.replace.value.metadata":                              "metadata",
+		"crs.type.replace.value.metadata.annotations":                  "annotations",
+		"crs.type.replace.value.metadata.labels":                       "labels",
+		"crs.type.replace.value.metadata.name":                         "name",
+		"crs.type.replace.value.metadata.namespace":                    "namespace",
+		"crs.type.replace.value.spec":                                  "spec",
+		"rebasedresources":                                             "rebasedResources",
+		"rebasedresources.crs":                                         "crs",
+		"rebasedresources.crs.prior":                                   "prior",
+		"rebasedresources.crs.prior.cr":                                "cr",
+		"rebasedresources.crs.prior.cr.apiversion":                     "apiVersion",
+		"rebasedresources.crs.prior.cr.kind":                           "kind",
+		"rebasedresources.crs.prior.cr.metadata":                       "metadata",
+		"rebasedresources.crs.prior.cr.metadata.annotations":           "annotations",
+		"rebasedresources.crs.prior.cr.metadata.labels":                "labels",
+		"rebasedresources.crs.prior.cr.metadata.name":                  "name",
+		"rebasedresources.crs.prior.cr.metadata.namespace":             "namespace",
+		"rebasedresources.crs.prior.cr.spec":                           "spec",
+		"rebasedresources.crs.prior.notpresent":                        "notPresent",
+		"rebasedresources.crs.type":                                    "type",
+		"rebasedresources.crs.type.create":                             "create",
+		"rebasedresources.crs.type.create.value":                       "value",
+		"rebasedresources.crs.type.create.value.apiversion":            "apiVersion",
+		"rebasedresources.crs.type.create.value.kind":                  "kind",
+		"rebasedresources.crs.type.create.value.metadata":              "metadata",
+		"rebasedresources.crs.type.create.value.metadata.annotations":  "annotations",
+		"rebasedresources.crs.type.create.value.metadata.labels":       "labels",
+		"rebasedresources.crs.type.create.value.metadata.name":         "name",
+		"rebasedresources.crs.type.create.value.metadata.namespace":    "namespace",
+		"rebasedresources.crs.type.create.value.spec":                  "spec",
+		"rebasedresources.crs.type.delete":                             "delete",
+		"rebasedresources.crs.type.delete.gvk":                         "gvk",
+		"rebasedresources.crs.type.delete.gvk.group":                   "group",
+		"rebasedresources.crs.type.delete.gvk.kind":                    "kind",
+		"rebasedresources.crs.type.delete.gvk.version":                 "version",
+		"rebasedresources.crs.type.delete.name":                        "name",
+		"rebasedresources.crs.type.delete.namespace":                   "namespace",
+		"rebasedresources.crs.type.modify":                             "modify",
+		"rebasedresources.crs.type.modify.value":                       "value",
+		"rebasedresources.crs.type.modify.value.apiversion":            "apiVersion",
+		"rebasedresources.crs.type.modify.value.kind":                  "kind",
+		"rebasedresources.crs.type.modify.value.metadata":              "metadata",
+		"rebasedresources.crs.type.modify.value.metadata.annotations":  "annotations",
+		"rebasedresources.crs.type.modify.value.metadata.labels":       "labels",
+		"rebasedresources.crs.type.modify.value.metadata.name":         "name",
+		"rebasedresources.crs.type.modify.value.metadata.namespace":    "namespace",
+		"rebasedresources.crs.type.modify.value.spec":                  "spec",
+		"rebasedresources.crs.type.patch":                              "patch",
+		"rebasedresources.crs.type.patch.patchops":                     "patchOps",
+		"rebasedresources.crs.type.patch.patchops.from":                "from",
+		"rebasedresources.crs.type.patch.patchops.op":                  "op",
+		"rebasedresources.crs.type.patch.patchops.path":                "path",
+		"rebasedresources.crs.type.patch.patchops.value":               "value",
+		"rebasedresources.crs.type.patch.patchops.xpermissive":         "x-permissive",
+		"rebasedresources.crs.type.patch.target":                       "target",
+		"rebasedresources.crs.type.patch.target.gvk":                   "gvk",
+		"rebasedresources.crs.type.patch.target.gvk.group":             "group",
+		"rebasedresources.crs.type.patch.target.gvk.kind":              "kind",
+		"rebasedresources.crs.type.patch.target.gvk.version":           "version",
+		"rebasedresources.crs.type.patch.target.name":                  "name",
+		"rebasedresources.crs.type.patch.target.namespace":             "namespace",
+		"rebasedresources.crs.type.replace":                            "replace",
+		"rebasedresources.crs.type.replace.value":                      "value",
+		"rebasedresources.crs.type.replace.value.apiversion":           "apiVersion",
+		"rebasedresources.crs.type.replace.value.kind":                 "kind",
+		"rebasedresources.crs.type.replace.value.metadata":             "metadata",
+		"rebasedresources.crs.type.replace.value.metadata.annotations": "annotations",
+		"rebasedresources.crs.type.replace.value.metadata.labels":      "labels",
+		"rebasedresources.crs.type.replace.value.metadata.name":        "name",
+		"rebasedresources.crs.type.replace.value.metadata.namespace":   "namespace",
+		"rebasedresources.crs.type.replace.value.spec":                 "spec",
+		"transactionconflict":                                          "transactionConflict",
+		"transactionconflict.head":                                     "head",
+		"transactionconflict.head.cr":                                  "cr",
+		"transactionconflict.head.cr.apiversion":                       "apiVersion",
+		"transactionconflict.head.cr.kind":                             "kind",
+		"transactionconflict.head.cr.metadata":                         "metadata",
+		"transactionconflict.head.cr.metadata.annotations":             "annotations",
+		"transactionconflict.head.cr.metadata.labels":                  "labels",
+		"transactionconflict.head.cr.metadata.name":                    "name",
+		"transactionconflict.head.cr.metadata.namespace":               "namespace",
+		"transactionconflict.head.cr.spec":                             "spec",
+		"transactionconflict.head.notpresent":                          "notPresent",
+		"transactionconflict.head.notpresent.gvk":                      "gvk",
+		"transactionconflict.head.notpresent.gvk.group":                "group",
+		"transactionconflict.head.notpresent.gvk.kind":                 "kind",
+		"transactionconflict.head.notpresent.gvk.version":              "version",
+		"transactionconflict.head.notpresent.name":                     "name",
+		"transactionconflict.head.notpresent.namespace":                "namespace",
+		"transactionconflict.reason":                                   "reason",
+	},
+	"POST /core/transaction/v3/resources": {
+		"cr":                      "cr",
+		"cr.apiversion":           "apiVersion",
+		"cr.kind":                 "kind",
+		"cr.metadata":             "metadata",
+		"cr.metadata.annotations": "annotations",
+		"cr.metadata.labels":      "labels",
+		"cr.metadata.name":        "name",
+		"cr.metadata.namespace":   "namespace",
+		"cr.spec":                 "spec",
+		"gvk":                     "gvk",
+		"gvk.group":               "group",
+		"gvk.kind":                "kind",
+		"gvk.version":             "version",
+		"name":                    "name",
+		"namespace":               "namespace",
+		"notpresent":              "notPresent",
+		"notpresent.gvk":          "gvk",
+		"notpresent.gvk.group":    "group",
+		"notpresent.gvk.kind":     "kind",
+		"notpresent.gvk.version":  "version",
+		"notpresent.name":         "name",
+		"notpresent.namespace":    "namespace",
+	},
+	"GET /core/transaction/v3/result/changedcrs/{transactionId}": {
+		"changedcrs":                 "changedCrs",
+		"changedcrs.gvk":             "gvk",
+		"changedcrs.gvk.group":       "group",
+		"changedcrs.gvk.kind":        "kind",
+		"changedcrs.gvk.version":     "version",
+		"changedcrs.hasmore":         "hasMore",
+		"changedcrs.names":           "names",
+		"changedcrs.names.name":      "name",
+		"changedcrs.names.namespace": "namespace",
+		"changedcrs.nextcursor":      "nextCursor",
+		"hasmore":                    "hasMore",
+		"nextcursor":                 "nextCursor",
+	},
+	"GET /core/transaction/v3/result/execution/{transactionId}": {
+		"changedcrscount":             "changedCrsCount",
+		"executionsummary":            "executionSummary",
+		"generalerrors":               "generalErrors",
+		"intentsruncount":             "intentsRunCount",
+		"nodeswithconfigchangescount": "nodesWithConfigChangesCount",
+		"outputcrscount":              "outputCrsCount",
+		"topologysupported":           "topologySupported",
+	},
+	"GET /core/transaction/v3/result/intentsrun/{transactionId}": {
+		"hasmore":                 "hasMore",
+		"intentsrun":              "intentsRun",
+		"intentsrun.errors":       "errors",
+		"intentsrun.errors.error": "error",
+		"intentsrun.errors.error.causecollection":        "causeCollection",
+		"intentsrun.errors.error.causeindexedcollection": "causeIndexedCollection",
+		"intentsrun.errors.error.causeisinternal":        "causeIsInternal",
+		"intentsrun.errors.error.causesimple":            "causeSimple",
+		"intentsrun.errors.error.causewrapped":           "causeWrapped",
+		"intentsrun.errors.error.domain":                 "domain",
+		"intentsrun.errors.error.index":                  "index",
+		"intentsrun.errors.error.message":                "message",
+		"intentsrun.errors.error.ref":                    "ref",
+		"intentsrun.errors.error.type":                   "type",
+		"intentsrun.errors.error.values":                 "values",
+		"intentsrun.errors.rawerror":                     "rawError",
+		"intentsrun.intentname":                          "intentName",
+		"intentsrun.intentname.gvk":                      "gvk",
+		"intentsrun.intentname.gvk.group":                "group",
+		"intentsrun.intentname.gvk.kind":                 "kind",
+		"intentsrun.intentname.gvk.version":              "version",
+		"intentsrun.intentname.name":                     "name",
+		"intentsrun.intentname.namespace":                "namespace",
+		"intentsrun.outputcrs":                           "outputCrs",
+		"intentsrun.outputcrs.gvk":                       "gvk",
+		"intentsrun.outputcrs.gvk.group":                 "group",
+		"intentsrun.outputcrs.gvk.kind":                  "kind",
+		"intentsrun.outputcrs.gvk.version":               "version",
+		"intentsrun.outputcrs.name":                      "name",
+		"intentsrun.outputcrs.namespace":                 "namespace",
+		"intentsrun.script":                              "script",
+		"intentsrun.script.executiontime":                "executionTime",
+		"nextcursor":                                     "nextCursor",
+	},
+	"GET /core/transaction/v3/result/nodes/{transactionId}": {
+		"hasmore":                          "hasMore",
+		"nextcursor":                       "nextCursor",
+		"nodeswithconfigchanges":           "nodesWithConfigChanges",
+		"nodeswithconfigchanges.errors":    "errors",
+		"nodeswithconfigchanges.name":      "name",
+		"nodeswithconfigchanges.namespace": "namespace",
+	},
+	"GET /core/user-storage/v2/directory": {
+		"directorypath":            "directory-path",
+		"entries":                  "entries",
+		"entries.modificationtime": "modification-time",
+		"entries.name":             "name",
+		"entries.type":             "type",
+	},
+	"GET /core/user-storage/v2/file": {
+		"filecontent":      "file-content",
+		"filedeleted":      "file-deleted",
+		"filename":         "file-name",
+		"modificationtime": "modification-time",
+	},
+	"PUT /core/user-storage/v2/file": {
+		"filecontent": "file-content",
+	},
+	"POST /core/user-storage/v2/file": {
+		"filecontent": "file-content",
+	},
+	"GET /core/user-storage/v2/shared/directory": {
+		"directorypath":            "directory-path",
+		"entries":                  "entries",
+		"entries.modificationtime": "modification-time",
+		"entries.name":             "name",
+		"entries.type":             "type",
+	},
+	"GET /core/user-storage/v2/shared/file": {
+		"filecontent":      "file-content",
+		"filedeleted":      "file-deleted",
+		"filename":         "file-name",
+		"modificationtime": "modification-time",
+	},
+	"PUT /core/user-storage/v2/shared/file": {
+		"filecontent": "file-content",
+	},
+	"POST /core/user-storage/v2/shared/file": {
+		"filecontent": "file-content",
+	},
+	"GET /core/workflows/v1": {
+		"creationtime":           "creationTime",
+		"group":                  "group",
+		"id":                     "id",
+		"kind":                   "kind",
+		"lastupdate":             "lastUpdate",
+		"name":                   "name",
+		"namespace":              "namespace",
+		"parent":                 "parent",
+		"parent.group":           "group",
+		"parent.id":              "id",
+		"parent.kind":            "kind",
+		"parent.name":            "name",
+		"parent.namespace":       "namespace",
+		"parent.version":         "version",
+		"rootworkflow":           "rootWorkflow",
+		"rootworkflow.group":     "group",
+		"rootworkflow.id":        "id",
+		"rootworkflow.kind":      "kind",
+		"rootworkflow.name":      "name",
+		"rootworkflow.namespace": "namespace",
+		"rootworkflow.version":   "version",
+		"state":                  "state",
+		"username":               "username",
+		"version":                "version",
+	},
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*labelSelectorFunction)(nil)
+
+var (
+	// labelNameRe matches a label name and a label value, both limited to 63 characters.
+	labelNameRe = regexp.MustCompile(`^[A-Za-z0-9]([-_.A-Za-z0-9]*[A-Za-z0-9])?$`)
+	// labelPrefixRe matches the DNS subdomain prefix of a label key, limited to 253 characters.
+	labelPrefixRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+func NewLabelSelectorFunction() function.Function {
+	return &labelSelectorFunction{}
+}
+
+// labelSelectorFunction builds an equality based label selector from a map of labels.
+type labelSelectorFunction struct{}
+
+func (f *labelSelectorFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "label_selector"
+}
+
+func (f *labelSelectorFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a label selector from a map of labels",
+		Description:         "Builds a label selector matching the resources that carry all the given labels, e.g. {\"eda.nokia.com/role\" = \"leaf\"} gives eda.nokia.com/role=leaf. The label keys and values are checked against the Kubernetes label syntax.",
+		MarkdownDescription: "Builds a label selector matching the resources that carry all the given labels, e.g. `{\"eda.nokia.com/role\" = \"leaf\"}` gives `eda.nokia.com/role=leaf`. The label keys and values are checked against the Kubernetes label syntax.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "labels",
+				ElementType:         types.StringType,
+				Description:         "The labels the resources must carry.",
+				MarkdownDescription: "The labels the resources must carry.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *labelSelectorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var labels map[string]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &labels))
+
+	if resp.Error != nil {
+		return
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	requirements := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if err := validateLabel(key, labels[key]); err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		requirements = append(requirements, key+"="+labels[key])
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(requirements, ",")))
+}
+
+// validateLabel checks a label key and value against the Kubernetes label syntax.
+func validateLabel(key, value string) error {
+	if err := validateLabelKey(key); err != nil {
+		return err
+	}
+	return validateLabelValue(key, value)
+}
+
+// validateLabelKey checks a label key, an optional DNS subdomain prefix and a name.
+func validateLabelKey(key string) error {
+	name := key
+	if prefix, rest, found := strings.Cut(key, "/"); found {
+		if len(prefix) > 253 || !labelPrefixRe.MatchString(prefix) {
+			return fmt.Errorf("invalid label key %q: the prefix must be a DNS subdomain", key)
+		}
+		name = rest
+	}
+	if len(name) > 63 || !labelNameRe.MatchString(name) {
+		return fmt.Errorf("invalid label key %q: the name must be at most 63 alphanumeric characters, '-', '_' or '.'", key)
+	}
+	return nil
+}
+
+// validateLabelValue checks the value of the label key, which may be empty.
+func validateLabelValue(key, value string) error {
+	if value != "" && (len(value) > 63 || !labelNameRe.MatchString(value)) {
+		return fmt.Errorf("invalid value %q of label %q: it must be at most 63 alphanumeric characters, '-', '_' or '.'", value, key)
+	}
+	return nil
+}
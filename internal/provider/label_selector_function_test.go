@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		labels  map[string]any
+		matches bool
+		wantErr bool
+	}{
+		{
+			name:    "equality",
+			input:   "eda.nokia.com/role=leaf",
+			labels:  map[string]any{"eda.nokia.com/role": "leaf"},
+			matches: true,
+		},
+		{
+			name:    "double equals and inequality",
+			input:   "role==leaf,site!=lab",
+			labels:  map[string]any{"role": "leaf", "site": "lab"},
+			matches: false,
+		},
+		{
+			name:    "set based",
+			input:   "role in (leaf, spine),site notin (lab)",
+			labels:  map[string]any{"role": "spine", "site": "prod"},
+			matches: true,
+		},
+		{
+			name:    "exists and does not exist",
+			input:   "role,!decommissioned",
+			labels:  map[string]any{"role": "leaf"},
+			matches: true,
+		},
+		{
+			name:    "empty value",
+			input:   "role=",
+			labels:  map[string]any{"role": ""},
+			matches: true,
+		},
+		{
+			name:    "value with equals sign",
+			input:   "a=b=c",
+			wantErr: true,
+		},
+		{
+			name:    "key with space",
+			input:   "my role=leaf",
+			wantErr: true,
+		},
+		{
+			name:    "invalid prefix",
+			input:   "EDA.nokia.com/role=leaf",
+			wantErr: true,
+		},
+		{
+			name:    "invalid set value",
+			input:   "role in (leaf, sp ine)",
+			wantErr: true,
+		},
+		{
+			name:    "empty key",
+			input:   "=leaf",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := parseLabelSelector(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseLabelSelector(%q) error = nil, want an error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseLabelSelector(%q) error = %v", tt.input, err)
+			}
+			if got := sel.Matches(tt.labels); got != tt.matches {
+				t.Errorf("parseLabelSelector(%q).Matches(%v) = %v, want %v", tt.input, tt.labels, got, tt.matches)
+			}
+		})
+	}
+}
+
+func TestValidateLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "prefixed key",
+			key:   "eda.nokia.com/role",
+			value: "leaf",
+		},
+		{
+			name:  "empty value",
+			key:   "role",
+			value: "",
+		},
+		{
+			name:    "name too long",
+			key:     "a123456789012345678901234567890123456789012345678901234567890123",
+			wantErr: true,
+		},
+		{
+			name:    "value with slash",
+			key:     "role",
+			value:   "leaf/spine",
+			wantErr: true,
+		},
+		{
+			name:    "name ending with dash",
+			key:     "role-",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLabel(tt.key, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateLabel(%q, %q) error = %v, wantErr %v", tt.key, tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
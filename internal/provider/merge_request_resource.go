@@ -0,0 +1,411 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_merge_request"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+const (
+	create_rs_mergeRequest = "/core/mergerequest/v1"
+	read_rs_mergeRequest   = "/core/mergerequest/v1/{mergeRequestId}"
+	update_rs_mergeRequest = "/core/mergerequest/v1/{mergeRequestId}"
+	delete_rs_mergeRequest = "/core/mergerequest/v1/{mergeRequestId}"
+	close_rs_mergeRequest  = "/core/mergerequest/v1/{mergeRequestId}/close"
+	reopen_rs_mergeRequest = "/core/mergerequest/v1/{mergeRequestId}/reopen"
+	merge_rs_mergeRequest  = "/core/mergerequest/v1/{mergeRequestId}/merge"
+)
+
+var (
+	_ resource.Resource                = (*mergeRequestResource)(nil)
+	_ resource.ResourceWithConfigure   = (*mergeRequestResource)(nil)
+	_ resource.ResourceWithImportState = (*mergeRequestResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*mergeRequestResource)(nil)
+)
+
+func NewMergeRequestResource() resource.Resource {
+	return &mergeRequestResource{}
+}
+
+type mergeRequestResource struct {
+	providerData
+}
+
+func (r *mergeRequestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge_request"
+}
+
+func (r *mergeRequestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_merge_request.MergeRequestResourceSchema(ctx)
+}
+
+// ModifyPlan keeps the transaction id of an already merged merge request, and rejects
+// changes to the content of a merged merge request since EDA can no longer apply them.
+func (r *mergeRequestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resource_merge_request.MergeRequestModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TransactionId.IsNull() || state.TransactionId.IsUnknown() {
+		if !plan.AutoMerge.ValueBool() || plan.Closed.ValueBool() {
+			plan.TransactionId = types.Int64Null()
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	if !plan.Crs.Equal(state.Crs) || !plan.Description.Equal(state.Description) {
+		resp.Diagnostics.AddError("Merge request already merged",
+			fmt.Sprintf("Merge request %d was merged by transaction %d and can no longer be changed, create a new merge request instead.",
+				state.Id.ValueInt64(), state.TransactionId.ValueInt64()))
+		return
+	}
+
+	plan.TransactionId = state.TransactionId
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *mergeRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_merge_request.MergeRequestModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, err := mergeRequestBody(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building request", err.Error())
+		return
+	}
+
+	// Create API call logic
+	tflog.Info(ctx, "Create()::API request", map[string]any{
+		"path": create_rs_mergeRequest,
+		"body": spew.Sdump(reqBody),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err = r.client.Create(ctx, create_rs_mergeRequest, nil, reqBody, &result)
+
+	tflog.Info(ctx, "Create()::API returned", map[string]any{
+		"path":      create_rs_mergeRequest,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model
+	anyVal, ok := result["id"]
+	if !ok {
+		resp.Diagnostics.AddError("Failed to build response from API result", "Merge request id missing from result")
+		return
+	}
+
+	id, err := tfutils.NumToInt64(anyVal)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing merge request id", err.Error())
+		return
+	}
+	data.Id = types.Int64Value(id)
+	data.TransactionId = types.Int64Null()
+
+	// Save the merge request before changing its state, so that it is tracked even if that fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Closed.ValueBool() {
+		err = r.action(ctx, close_rs_mergeRequest, id, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error closing merge request", err.Error())
+			return
+		}
+	} else if data.AutoMerge.ValueBool() {
+		data.TransactionId, err = r.merge(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error merging merge request", err.Error())
+			return
+		}
+	}
+
+	// Save created data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *mergeRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_merge_request.MergeRequestModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": read_rs_mergeRequest,
+		"data": spew.Sdump(data),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.Get(ctx, read_rs_mergeRequest, map[string]string{
+		"mergeRequestId": tfutils.StringValue(data.Id),
+	}, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      read_rs_mergeRequest,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if apiclient.IsNotFound(err) {
+		// The merge request was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// The CRs are returned in API form, so only the description is refreshed
+	if description, ok := result["description"].(string); ok {
+		data.Description = types.StringValue(description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mergeRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state resource_merge_request.MergeRequestModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Id.ValueInt64()
+	data.Id = state.Id
+
+	if !data.Crs.Equal(state.Crs) || !data.Description.Equal(state.Description) {
+		reqBody, err := mergeRequestBody(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("Error building request", err.Error())
+			return
+		}
+
+		// Update API call logic
+		tflog.Info(ctx, "Update()::API request", map[string]any{
+			"path": update_rs_mergeRequest,
+			"body": spew.Sdump(reqBody),
+		})
+
+		t0 := time.Now()
+		result := map[string]any{}
+
+		err = r.client.Update(ctx, update_rs_mergeRequest, map[string]string{
+			"mergeRequestId": strconv.FormatInt(id, 10),
+		}, reqBody, &result)
+
+		tflog.Info(ctx, "Update()::API returned", map[string]any{
+			"path":      update_rs_mergeRequest,
+			"result":    spew.Sdump(result),
+			"timeTaken": time.Since(t0).String(),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating resource", err.Error())
+			return
+		}
+	}
+
+	var err error
+	if data.Closed.ValueBool() != state.Closed.ValueBool() {
+		actionPath := reopen_rs_mergeRequest
+		if data.Closed.ValueBool() {
+			actionPath = close_rs_mergeRequest
+		}
+		err = r.action(ctx, actionPath, id, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating merge request state", err.Error())
+			return
+		}
+	}
+
+	data.TransactionId = state.TransactionId
+	if data.TransactionId.IsNull() && data.AutoMerge.ValueBool() && !data.Closed.ValueBool() {
+		data.TransactionId, err = r.merge(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error merging merge request", err.Error())
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mergeRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_merge_request.MergeRequestModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	tflog.Info(ctx, "Delete()::API request", map[string]any{
+		"path": delete_rs_mergeRequest,
+		"id":   data.Id,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.Delete(ctx, delete_rs_mergeRequest, map[string]string{
+		"mergeRequestId": tfutils.StringValue(data.Id),
+	}, &result)
+
+	tflog.Info(ctx, "Delete()::API returned", map[string]any{
+		"path":      delete_rs_mergeRequest,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil && !apiclient.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting resource", err.Error())
+		return
+	}
+}
+
+// merge merges the merge request and returns the id of the resulting transaction.
+func (r *mergeRequestResource) merge(ctx context.Context, id int64) (types.Int64, error) {
+	result := map[string]any{}
+	err := r.action(ctx, merge_rs_mergeRequest, id, &result)
+	if err != nil {
+		return types.Int64Null(), err
+	}
+
+	anyVal, ok := result["id"]
+	if !ok {
+		return types.Int64Null(), fmt.Errorf("transaction id missing from result")
+	}
+	transactionId, err := tfutils.NumToInt64(anyVal)
+	if err != nil {
+		return types.Int64Null(), err
+	}
+	return types.Int64Value(transactionId), nil
+}
+
+// action posts to one of the close, reopen or merge endpoints of a merge request.
+func (r *mergeRequestResource) action(ctx context.Context, actionPath string, id int64, result *map[string]any) error {
+	var reqBody any
+	if actionPath == merge_rs_mergeRequest {
+		reqBody = map[string]any{"dryRun": false}
+	}
+	if result == nil {
+		result = &map[string]any{}
+	}
+
+	tflog.Info(ctx, "action()::API request", map[string]any{
+		"path": actionPath,
+		"id":   id,
+	})
+
+	t0 := time.Now()
+
+	err := r.client.Create(ctx, actionPath, map[string]string{
+		"mergeRequestId": strconv.FormatInt(id, 10),
+	}, reqBody, result)
+
+	tflog.Info(ctx, "action()::API returned", map[string]any{
+		"path":      actionPath,
+		"result":    spew.Sdump(*result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	return err
+}
+
+// mergeRequestBody builds the request body from the crs and description of the model,
+// the remaining attributes only drive what the provider does with the merge request.
+func mergeRequestBody(ctx context.Context, data *resource_merge_request.MergeRequestModel) (map[string]any, error) {
+	// The CRs are sent verbatim, their keys are not attribute names
+	crs, err := tfutils.DynamicToAny(ctx, data.Crs)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"crs":         crs,
+		"description": data.Description.ValueString(),
+	}, nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mergeRequestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *mergeRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected id = <merge request id> format, got: id = %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_merge_request"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+func TestMergeRequestBody(t *testing.T) {
+	cr := map[string]any{
+		"type": map[string]any{
+			"create": map[string]any{
+				"value": map[string]any{
+					"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+					"kind":       "Interface",
+					"metadata":   map[string]any{"name": "leaf1-ethernet-1-1", "namespace": "eda"},
+					"spec":       map[string]any{"admin_state": "enable", "lldp": true},
+				},
+			},
+		},
+	}
+	crs, err := tfutils.AnyToDynamic([]any{cr})
+	if err != nil {
+		t.Fatalf("AnyToDynamic() error = %v", err)
+	}
+	data := &resource_merge_request.MergeRequestModel{
+		Crs:         crs,
+		Description: types.StringValue("add leaf1 interface"),
+	}
+
+	got, err := mergeRequestBody(context.Background(), data)
+	if err != nil {
+		t.Fatalf("mergeRequestBody() error = %v", err)
+	}
+	// The CRs are sent verbatim, without converting their keys to camelCase
+	want := map[string]any{
+		"crs":         []any{cr},
+		"description": "add leaf1 interface",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeRequestBody() = %#v, want %#v", got, want)
+	}
+}
@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_namespaces"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewNamespacesDataSource() datasource.DataSource {
 }
 
 type namespacesDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// namespacesDataSourceModel is the model of the data source with the extra attribute.
+type namespacesDataSourceModel struct {
+	datasource_namespaces.NamespacesModel
+	tfutils.ExtraModel
 }
 
 func (d *namespacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *namespacesDataSource) Metadata(ctx context.Context, req datasource.Meta
 }
 
 func (d *namespacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_namespaces.NamespacesDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_namespaces.NamespacesDataSourceSchema(ctx))
 }
 
 func (d *namespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_namespaces.NamespacesModel
+	var data namespacesDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *namespacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.NamespacesModel, oasQueryParams(http.MethodGet, read_ds_namespaces))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -61,7 +69,7 @@ func (d *namespacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	})
 
 	t0 := time.Now()
-	result := []any{}
+	result := map[string]any{}
 	err = d.client.GetByQuery(ctx, read_ds_namespaces, nil, queryParams, &result)
 
 	tflog.Info(ctx, "Read()::API returned", map[string]any{
@@ -75,12 +83,13 @@ func (d *namespacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	newResult := map[string]any{
-		"namespaces": result,
-	}
-
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, newResult, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_namespaces)
+	err = decoder.AnyMapToModel(ctx, result, &data.NamespacesModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.NamespacesModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -98,14 +107,14 @@ func (r *namespacesDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
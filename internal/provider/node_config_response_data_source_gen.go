@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_node_config_response"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewNodeConfigResponseDataSource() datasource.DataSource {
 }
 
 type nodeConfigResponseDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// nodeConfigResponseDataSourceModel is the model of the data source with the extra attribute.
+type nodeConfigResponseDataSourceModel struct {
+	datasource_node_config_response.NodeConfigResponseModel
+	tfutils.ExtraModel
 }
 
 func (d *nodeConfigResponseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *nodeConfigResponseDataSource) Metadata(ctx context.Context, req datasou
 }
 
 func (d *nodeConfigResponseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_node_config_response.NodeConfigResponseDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_node_config_response.NodeConfigResponseDataSourceSchema(ctx))
 }
 
 func (d *nodeConfigResponseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_node_config_response.NodeConfigResponseModel
+	var data nodeConfigResponseDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *nodeConfigResponseDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.NodeConfigResponseModel, oasQueryParams(http.MethodGet, read_ds_nodeConfigResponse))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -79,7 +87,12 @@ func (d *nodeConfigResponseDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_nodeConfigResponse)
+	err = decoder.AnyMapToModel(ctx, result, &data.NodeConfigResponseModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.NodeConfigResponseModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -97,14 +110,14 @@ func (r *nodeConfigResponseDataSource) Configure(_ context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -3,13 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_nql_stream_result"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +25,7 @@ func NewNqlStreamResultDataSource() datasource.DataSource {
 }
 
 type nqlStreamResultDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *nqlStreamResultDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +33,11 @@ func (d *nqlStreamResultDataSource) Metadata(ctx context.Context, req datasource
 }
 
 func (d *nqlStreamResultDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_nql_stream_result.NqlStreamResultDataSourceSchema(ctx)
+	resp.Schema = datasource_nql_stream_result.NqlStreamResultCustomDataSourceSchema(ctx)
 }
 
 func (d *nqlStreamResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_nql_stream_result.NqlStreamResultModel
+	var data datasource_nql_stream_result.NqlStreamResultCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +47,7 @@ func (d *nqlStreamResultDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, read_ds_nqlStreamResult))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,7 +76,11 @@ func (d *nqlStreamResultDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	err = d.oasDecoder(http.MethodGet, read_ds_nqlStreamResult).AnyMapToModel(ctx, result, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Columns, data.Rows, err = queryResultRows(ctx, result)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -94,14 +98,14 @@ func (r *nqlStreamResultDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
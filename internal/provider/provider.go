@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -34,6 +36,9 @@ const (
 	ENV_REST_TIMEOUT        = "REST_TIMEOUT"
 	ENV_REST_RETRIES        = "REST_RETRIES"
 	ENV_REST_RETRY_INTERVAL = "REST_RETRY_INTERVAL"
+	ENV_TX_BATCH_SIZE       = "TRANSACTION_BATCH_SIZE"
+	ENV_TX_BATCH_WINDOW     = "TRANSACTION_BATCH_WINDOW"
+	ENV_STRICT_DECODING     = "STRICT_DECODING"
 
 	// Default values
 	DEF_KC_REALM            = "master"
@@ -45,9 +50,15 @@ const (
 	DEF_REST_TIMEOUT        = 15 * time.Second
 	DEF_REST_RETRIES        = 3
 	DEF_REST_RETRY_INTERVAL = 5 * time.Second
+	DEF_TX_BATCH_SIZE       = 100
+	DEF_TX_BATCH_WINDOW     = 500 * time.Millisecond
+	DEF_STRICT_DECODING     = true
 )
 
-var _ provider.Provider = (*coreProvider)(nil)
+var (
+	_ provider.Provider              = (*coreProvider)(nil)
+	_ provider.ProviderWithFunctions = (*coreProvider)(nil)
+)
 
 func New(ver string) func() provider.Provider {
 	return func() provider.Provider {
@@ -59,6 +70,15 @@ type coreProvider struct {
 	version string
 }
 
+// providerData is passed to the data sources and resources when the provider is configured.
+// The resources share its batcher, so CRs applied together end up in the same transaction.
+type providerData struct {
+	client  *apiclient.EdaApiClient
+	batcher *crBatcher
+	// strictDecoding is set by the strict_decoding option, see oasDecoder.
+	strictDecoding bool
+}
+
 type providerModel struct {
 	BaseURL           types.String `tfsdk:"base_url"`
 	KcUsername        types.String `tfsdk:"kc_username"`
@@ -75,6 +95,9 @@ type providerModel struct {
 	RestTimeout       types.String `tfsdk:"rest_timeout"`
 	RestRetries       types.Int64  `tfsdk:"rest_retries"`
 	RestRetryInterval types.String `tfsdk:"rest_retry_interval"`
+	TxBatchSize       types.Int64  `tfsdk:"transaction_batch_size"`
+	TxBatchWindow     types.String `tfsdk:"transaction_batch_window"`
+	StrictDecoding    types.Bool   `tfsdk:"strict_decoding"`
 }
 
 func (p *coreProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -142,6 +165,19 @@ func (p *coreProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Description: "REST Retry Interval",
 				Optional:    true,
 			},
+			"transaction_batch_size": schema.Int64Attribute{
+				Description: "Maximum number of CR changes posted in a single transaction, 1 disables batching",
+				Optional:    true,
+			},
+			"transaction_batch_window": schema.StringAttribute{
+				Description: "How long to collect CR changes before posting them in a single transaction",
+				Optional:    true,
+			},
+			"strict_decoding": schema.BoolAttribute{
+				Description: "Fail when an API response does not match the schema, defaults to true. " +
+					"If false, the attributes that cannot be decoded are set to null with a warning",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -183,9 +219,19 @@ func (p *coreProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 		return
 	}
+	batchSize, batchWindow := batchConfig(&resp.Diagnostics, &data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Make the EDA API client available during DataSource and Resource type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	pd := &providerData{
+		client:         client,
+		batcher:        newCrBatcher(client, batchSize, batchWindow),
+		strictDecoding: strictDecodingConfig(&data),
+	}
+	resp.DataSourceData = pd
+	resp.ResourceData = pd
 
 	tflog.Info(ctx, "Configured EDA API client", map[string]any{"success": true})
 }
@@ -249,73 +295,79 @@ func (p *coreProvider) Metadata(ctx context.Context, req provider.MetadataReques
 	resp.Version = p.version
 }
 
+//go:generate go run ../gen/wrappers -config ../../specs/config.yml -oas ../../specs/oas.json
+
+// DataSources returns the data sources of specs/config.yml, generated unless written by hand,
+// and the ones that are not backed by an API operation of the config.
 func (p *coreProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
-		NewAlarmDataSource,
-		NewAlarmHistoryDataSource,
-		NewAlarmsDataSource,
-		NewAuthPasswordPolicyDataSource,
-		NewAuthProviderDataSource,
-		NewAuthProvidersDataSource,
-		NewAuthRoleDataSource,
-		NewAuthRolesDataSource,
-		NewAuthUserDataSource,
-		NewAuthUserGroupDataSource,
-		NewAuthUserGroupsDataSource,
-		NewAuthUsersDataSource,
-		NewClusterAlarmDataSource,
-		NewClusterAlarmHistoryDataSource,
-		NewClusterAlarmsDataSource,
-		NewClusterAuthRoleDataSource,
-		NewClusterAuthRolesDataSource,
-		NewConversationHistoryDataSource,
-		NewConversationListDataSource,
-		NewDbGetResultDataSource,
-		NewDbGetSchemaDataSource,
-		NewEqlStreamResultDataSource,
-		NewGroupRolesDataSource,
-		NewHealthDataSource,
-		NewNamespacesDataSource,
-		NewNodeConfigResponseDataSource,
-		NewNqlStreamResultDataSource,
-		NewOverlayDataSource,
-		NewOverlaysDataSource,
-		NewQueryCompletionResponseDataSource,
-		NewStoreAppInstalledSettingsDataSource,
-		NewStoreAppManifestDataSource,
-		NewStoreAppRequirementsGraphDataSource,
-		NewStoreAppSettingsDefinitionDataSource,
-		NewStoreAppSummaryDataSource,
-		NewStoreAppSummaryListDataSource,
-		NewStoreAppVersionListDataSource,
-		NewStoreCategoryListDataSource,
-		NewStreamResultDataSource,
-		NewTopologiesDataSource,
-		NewTopologyDataSource,
-		NewTopologyGroupingInstanceDataSource,
-		NewTopologyGroupingsListDataSource,
-		NewTransactionExecutionResultDataSource,
-		NewTransactionNodeConfigDiffDataSource,
-		NewTransactionResourceDiffDataSource,
-		NewTransactionResultInputResourcesDataSource,
-		NewTransactionStateDataSource,
-		NewTransactionSummaryResultDataSource,
-		NewTransactionSummaryResultsDataSource,
-		NewUserStorageDirDataSource,
-		NewUserStorageFileDataSource,
-		NewUserStorageSharedDirDataSource,
-		NewUserStorageSharedFileDataSource,
-		NewWorkflowStatusSummaryDataSource,
-	}
+	return slices.Concat(
+		configDataSources,
+		[]func() datasource.DataSource{
+			NewAccessCheckDataSource,
+			NewActivityDataSource,
+			NewAuthProviderTestDataSource,
+			NewBranchDiffSummaryDataSource,
+			NewBranchStatusDataSource,
+			NewCrDataSource,
+			NewCrsDataSource,
+			NewTopologyStateDataSource,
+			NewVersionDataSource,
+		},
+	)
 }
 
+// Resources returns the resources of specs/config.yml and the ones managed otherwise.
 func (p *coreProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
-		NewAuthProviderResource,
-		NewAuthRoleResource,
-		NewAuthUserResource,
-		NewAuthUserGroupResource,
-		NewClusterAuthRoleResource,
-		NewTransactionResource,
+	return slices.Concat(
+		configResources,
+		[]func() resource.Resource{
+			NewAlarmAcknowledgementResource,
+			NewCrResource,
+			NewMergeRequestResource,
+			NewTransactionResource,
+			NewUserStorageFileResource,
+			NewUserStorageSharedFileResource,
+		},
+	)
+}
+
+func (p *coreProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewEqlQuoteFunction,
+		NewEqlValidateFunction,
+		NewEqlValidateWhereFunction,
+		NewLabelSelectorFunction,
+		NewTxCreateFunction,
+		NewTxDeleteFunction,
+		NewTxModifyFunction,
+		NewTxPatchFunction,
+		NewTxReplaceFunction,
+	}
+}
+
+// strictDecodingConfig returns whether API responses are decoded strictly, which is not
+// part of the API client config either.
+func strictDecodingConfig(data *providerModel) bool {
+	if !data.StrictDecoding.IsNull() {
+		return data.StrictDecoding.ValueBool()
+	}
+	return utils.GetEnvBoolWithDefault(ENV_STRICT_DECODING, DEF_STRICT_DECODING)
+}
+
+// batchConfig returns the transaction batching settings, which are not part of the API client config.
+func batchConfig(diags *diag.Diagnostics, data *providerModel) (int, time.Duration) {
+	batchSize := utils.GetEnvIntWithDefault(ENV_TX_BATCH_SIZE, DEF_TX_BATCH_SIZE)
+	if !data.TxBatchSize.IsNull() {
+		batchSize = int(data.TxBatchSize.ValueInt64())
+	}
+
+	batchWindow := utils.GetEnvDurationWithDefault(ENV_TX_BATCH_WINDOW, DEF_TX_BATCH_WINDOW)
+	if !data.TxBatchWindow.IsNull() {
+		var err error
+		batchWindow, err = time.ParseDuration(data.TxBatchWindow.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("transaction_batch_window"), "Invalid transaction batch window", err.Error())
+		}
 	}
+	return batchSize, batchWindow
 }
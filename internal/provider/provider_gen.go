@@ -0,0 +1,80 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// configDataSources are the data sources declared in specs/config.yml.
+var configDataSources = []func() datasource.DataSource{
+	NewAlarmDataSource,
+	NewAlarmHistoryDataSource,
+	NewAlarmsDataSource,
+	NewAuthPasswordPolicyDataSource,
+	NewAuthProviderDataSource,
+	NewAuthProvidersDataSource,
+	NewAuthRoleDataSource,
+	NewAuthRolesDataSource,
+	NewAuthUserDataSource,
+	NewAuthUserGroupDataSource,
+	NewAuthUserGroupsDataSource,
+	NewAuthUsersDataSource,
+	NewClusterAlarmDataSource,
+	NewClusterAlarmHistoryDataSource,
+	NewClusterAlarmsDataSource,
+	NewClusterAuthRoleDataSource,
+	NewClusterAuthRolesDataSource,
+	NewConversationHistoryDataSource,
+	NewConversationListDataSource,
+	NewDbGetResultDataSource,
+	NewDbGetSchemaDataSource,
+	NewEqlStreamResultDataSource,
+	NewGroupRolesDataSource,
+	NewHealthDataSource,
+	NewNamespacesDataSource,
+	NewNodeConfigResponseDataSource,
+	NewNqlStreamResultDataSource,
+	NewOverlayDataSource,
+	NewOverlaysDataSource,
+	NewQueryCompletionResponseDataSource,
+	NewStoreAppInstalledSettingsDataSource,
+	NewStoreAppManifestDataSource,
+	NewStoreAppRequirementsGraphDataSource,
+	NewStoreAppSettingsDefinitionDataSource,
+	NewStoreAppSummaryDataSource,
+	NewStoreAppSummaryListDataSource,
+	NewStoreAppVersionListDataSource,
+	NewStoreCategoryListDataSource,
+	NewStreamResultDataSource,
+	NewTopologiesDataSource,
+	NewTopologyDataSource,
+	NewTopologyGroupingInstanceDataSource,
+	NewTopologyGroupingsListDataSource,
+	NewTransactionExecutionResultDataSource,
+	NewTransactionExecutionResultWithCountsDataSource,
+	NewTransactionNodeConfigDiffDataSource,
+	NewTransactionNodesResultDataSource,
+	NewTransactionResourceDiffDataSource,
+	NewTransactionResultChangedCrsDataSource,
+	NewTransactionResultInputResourcesDataSource,
+	NewTransactionResultIntentsRunDataSource,
+	NewTransactionStateDataSource,
+	NewTransactionSummaryResultDataSource,
+	NewTransactionSummaryResultsDataSource,
+	NewUserStorageDirDataSource,
+	NewUserStorageFileDataSource,
+	NewUserStorageSharedDirDataSource,
+	NewUserStorageSharedFileDataSource,
+	NewWorkflowStatusSummaryDataSource,
+}
+
+// configResources are the resources declared in specs/config.yml.
+var configResources = []func() resource.Resource{
+	NewAuthProviderResource,
+	NewAuthRoleResource,
+	NewAuthUserResource,
+	NewAuthUserGroupResource,
+	NewClusterAuthRoleResource,
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+)
+
+func TestProviderSchema(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+
+	// The framework validates the schemas of the data sources and resources, such as the
+	// extra attributes added to the generated ones, when it returns them.
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("GetProviderSchema() %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if len(resp.DataSourceSchemas) == 0 || len(resp.ResourceSchemas) == 0 {
+		t.Errorf("GetProviderSchema() returned %d data sources and %d resources", len(resp.DataSourceSchemas), len(resp.ResourceSchemas))
+	}
+}
+
+// newTestProviderData returns the provider data of a client of a fake API, which logs in
+// any user and passes the other requests to handler.
+func newTestProviderData(t *testing.T, handler http.HandlerFunc) providerData {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, apiclient.KEYCLOAK_URL) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token": "token", "expires_in": 300}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := apiclient.NewEdaApiClient(context.Background(), &apiclient.Config{
+		BaseURL:         server.URL,
+		EdaRealm:        "eda",
+		EdaClientID:     "eda",
+		EdaClientSecret: "secret",
+		RestTimeout:     5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewEdaApiClient() error = %v", err)
+	}
+	return providerData{
+		client:         client,
+		batcher:        newCrBatcher(client, 1, 0),
+		strictDecoding: true,
+	}
+}
+
+// writeJSON writes a JSON response with the status code.
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
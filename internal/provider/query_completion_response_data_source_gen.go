@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_query_completion_response"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewQueryCompletionResponseDataSource() datasource.DataSource {
 }
 
 type queryCompletionResponseDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// queryCompletionResponseDataSourceModel is the model of the data source with the extra attribute.
+type queryCompletionResponseDataSourceModel struct {
+	datasource_query_completion_response.QueryCompletionResponseModel
+	tfutils.ExtraModel
 }
 
 func (d *queryCompletionResponseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *queryCompletionResponseDataSource) Metadata(ctx context.Context, req da
 }
 
 func (d *queryCompletionResponseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_query_completion_response.QueryCompletionResponseDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_query_completion_response.QueryCompletionResponseDataSourceSchema(ctx))
 }
 
 func (d *queryCompletionResponseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_query_completion_response.QueryCompletionResponseModel
+	var data queryCompletionResponseDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *queryCompletionResponseDataSource) Read(ctx context.Context, req dataso
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.QueryCompletionResponseModel, oasQueryParams(http.MethodGet, read_ds_queryCompletionResponse))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,7 +84,12 @@ func (d *queryCompletionResponseDataSource) Read(ctx context.Context, req dataso
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_queryCompletionResponse)
+	err = decoder.AnyMapToModel(ctx, result, &data.QueryCompletionResponseModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.QueryCompletionResponseModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -94,14 +107,14 @@ func (r *queryCompletionResponseDataSource) Configure(_ context.Context, req dat
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
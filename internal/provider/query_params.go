@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+//go:generate go run ../gen/queryparams -oas ../../specs/oas.json -out query_params_gen.go
+
+var pathParamRe = regexp.MustCompile(`\{[^}]*\}`)
+
+// oasQueryParams returns the query parameters of the API operation with the given method and path.
+func oasQueryParams(method, path string) []tfutils.QueryParam {
+	return oasLookup(oasQueryParamTable, method, path)
+}
+
+// oasLookup returns the entry of a table generated from the specification for the API operation
+// with the given method and path. The path parameters may be named differently than in the
+// specification, e.g. {alarm_name}.
+func oasLookup[T any](table map[string]T, method, path string) T {
+	if entry, ok := table[method+" "+path]; ok {
+		return entry
+	}
+	op := method + " " + pathParamRe.ReplaceAllString(path, "{}")
+	for key, entry := range table {
+		if pathParamRe.ReplaceAllString(key, "{}") == op {
+			return entry
+		}
+	}
+	var zero T
+	return zero
+}
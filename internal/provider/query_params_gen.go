@@ -0,0 +1,343 @@
+// Code generated by queryparams from specs/oas.json. DO NOT EDIT.
+
+package provider
+
+import "github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+
+// oasQueryParamTable holds the query parameters of the API operations, by method and path.
+var oasQueryParamTable = map[string][]tfutils.QueryParam{
+	"POST /core/access/v1/checkaccess": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/access/v1/namespaces": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/admin/federationproviders": {
+		{Name: "name", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/admin/groups": {
+		{Name: "full-roles", Type: "boolean", Style: "form", Explode: true},
+		{Name: "full-users", Type: "boolean", Style: "form", Explode: true},
+		{Name: "fullRoles", Type: "boolean", Style: "form", Explode: true},
+		{Name: "fullUsers", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/admin/groups/{uuid}": {
+		{Name: "full-roles", Type: "boolean", Style: "form", Explode: true},
+		{Name: "full-users", Type: "boolean", Style: "form", Explode: true},
+		{Name: "fullRoles", Type: "boolean", Style: "form", Explode: true},
+		{Name: "fullUsers", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/admin/namespaces/{namespace}/roles": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/admin/namespaces/{namespace}/roles/{name}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/admin/passwordpolicy": {
+		{Name: "get-default", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/admin/roles": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/admin/roles/{name}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/admin/users": {
+		{Name: "email", Type: "string", Style: "form", Explode: true},
+		{Name: "username", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/alarm/v2/alarms": {
+		{Name: "all", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "fields", Type: "string", Style: "form", Explode: true},
+		{Name: "filter", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"PUT /core/alarm/v2/alarms": {
+		{Name: "action", Type: "string", Style: "form", Explode: true},
+		{Name: "duration", Type: "integer", Style: "form", Explode: true},
+	},
+	"GET /core/alarm/v2/alarms/{alarm-name}": {
+		{Name: "all", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"PUT /core/alarm/v2/alarms/{alarm-name}": {
+		{Name: "action", Type: "string", Style: "form", Explode: true},
+		{Name: "duration", Type: "integer", Style: "form", Explode: true},
+	},
+	"GET /core/alarm/v2/alarms/{alarm-name}/history": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/alarm/v2/namespaces/{nsName}/alarms": {
+		{Name: "all", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "fields", Type: "string", Style: "form", Explode: true},
+		{Name: "filter", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"PUT /core/alarm/v2/namespaces/{nsName}/alarms": {
+		{Name: "action", Type: "string", Style: "form", Explode: true},
+		{Name: "duration", Type: "integer", Style: "form", Explode: true},
+	},
+	"GET /core/alarm/v2/namespaces/{nsName}/alarms/{alarm-name}": {
+		{Name: "all", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"PUT /core/alarm/v2/namespaces/{nsName}/alarms/{alarm-name}": {
+		{Name: "action", Type: "string", Style: "form", Explode: true},
+		{Name: "duration", Type: "integer", Style: "form", Explode: true},
+	},
+	"GET /core/alarm/v2/namespaces/{nsName}/alarms/{alarm-name}/history": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/branches/v1/crdiff/{hash}": {
+		{Name: "group", Type: "string", Style: "form", Explode: true},
+		{Name: "kind", Type: "string", Style: "form", Explode: true},
+		{Name: "name", Type: "string", Style: "form", Explode: true},
+		{Name: "namespace", Type: "string", Style: "form", Explode: true},
+		{Name: "version", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/branches/v1/diffsummary": {
+		{Name: "cursor", Type: "integer", Style: "form", Explode: true},
+		{Name: "gvk", Type: "string", Style: "form", Explode: true},
+		{Name: "limit", Type: "integer", Style: "form", Explode: true},
+		{Name: "search", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/branches/v1/diffsummary/{hash}": {
+		{Name: "cursor", Type: "integer", Style: "form", Explode: true},
+		{Name: "gvk", Type: "string", Style: "form", Explode: true},
+		{Name: "limit", Type: "integer", Style: "form", Explode: true},
+		{Name: "search", Type: "string", Style: "form", Explode: true},
+	},
+	"POST /core/chat/v1": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/db/v2/data": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "fields", Type: "string", Style: "form", Explode: true},
+		{Name: "filter", Type: "string", Style: "form", Explode: true},
+		{Name: "includeKeys", Type: "boolean", Style: "form", Explode: true},
+		{Name: "jsPath", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+		{Name: "updates-only", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/db/v2/schema": {
+		{Name: "removeReadOnly", Type: "boolean", Style: "form", Explode: true},
+		{Name: "tableName", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/query/v1": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "namespaces", Type: "string", Style: "form", Explode: true},
+		{Name: "query", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/query/v1/eql": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "namespaces", Type: "string", Style: "form", Explode: true},
+		{Name: "query", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/query/v1/eql/autocomplete": {
+		{Name: "completion_limit", Type: "integer", Style: "form", Explode: true},
+		{Name: "query", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/query/v1/nql": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "namespaces", Type: "string", Style: "form", Explode: true},
+		{Name: "query", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/appsettingsdefinition/catalog/{catalog}/app/{appId}": {
+		{Name: "commitHash", Type: "string", Style: "form", Explode: true},
+		{Name: "semVer", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/appsummaries": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "installed", Type: "boolean", Style: "form", Explode: true},
+		{Name: "prioritizeInstalledInfo", Type: "boolean", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+		{Name: "upgradable", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/appsummaries/app/{appId}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "installed", Type: "boolean", Style: "form", Explode: true},
+		{Name: "prioritizeInstalledInfo", Type: "boolean", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+		{Name: "upgradable", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/file/catalog/{catalog}/app/{appId}/{path}": {
+		{Name: "commitHash", Type: "string", Style: "form", Explode: true},
+		{Name: "semVer", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/installedsettings/app/{appId}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/manifest/catalog/{catalog}/app/{appId}": {
+		{Name: "commitHash", Type: "string", Style: "form", Explode: true},
+		{Name: "semVer", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/store/v1/requirementsgraph/catalog/{catalog}/app/{appId}": {
+		{Name: "commitHash", Type: "string", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "semVer", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/topology/v1": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/topology/v1/{topologyName}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/topology/v1/{topologyName}/groupings": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/topology/v1/{topologyName}/groupings/{groupingName}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/topology/v1/{topologyName}/overlay": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/topology/v1/{topologyName}/overlay/{overlayName}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"POST /core/topology/v1/{topologyName}/state": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/result/diffs/nodecfg/{transactionId}": {
+		{Name: "namespace", Type: "string", Style: "form", Explode: true},
+		{Name: "node", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/result/diffs/resource/{transactionId}": {
+		{Name: "group", Type: "string", Style: "form", Explode: true},
+		{Name: "kind", Type: "string", Style: "form", Explode: true},
+		{Name: "name", Type: "string", Style: "form", Explode: true},
+		{Name: "namespace", Type: "string", Style: "form", Explode: true},
+		{Name: "version", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/result/execution/{transactionId}": {
+		{Name: "failOnErrors", Type: "string", Style: "form", Explode: true},
+		{Name: "waitForComplete", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/result/inputresources/{transactionId}": {
+		{Name: "full", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/result/summary": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "size", Type: "integer", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+		{Name: "username", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/result/summary/{transactionId}": {
+		{Name: "waitForComplete", Type: "boolean", Style: "form", Explode: true},
+	},
+	"POST /core/transaction/v2/result/topology/{transactionId}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v2/state/{transactionId}": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"POST /core/transaction/v3/resources": {
+		{Name: "hash", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v3/result/changedcrs/{transactionId}": {
+		{Name: "cursor", Type: "integer", Style: "form", Explode: true},
+		{Name: "gvk", Type: "string", Style: "form", Explode: true},
+		{Name: "limit", Type: "integer", Style: "form", Explode: true},
+		{Name: "search", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v3/result/execution/{transactionId}": {
+		{Name: "failOnErrors", Type: "string", Style: "form", Explode: true},
+		{Name: "waitForComplete", Type: "boolean", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v3/result/intentsrun/{transactionId}": {
+		{Name: "cursor", Type: "integer", Style: "form", Explode: true},
+		{Name: "limit", Type: "integer", Style: "form", Explode: true},
+		{Name: "search", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/transaction/v3/result/nodes/{transactionId}": {
+		{Name: "cursor", Type: "integer", Style: "form", Explode: true},
+		{Name: "limit", Type: "integer", Style: "form", Explode: true},
+		{Name: "search", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/user-storage/v2/directory": {
+		{Name: "base64-encode", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"DELETE /core/user-storage/v2/directory": {
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/user-storage/v2/file": {
+		{Name: "base64-encode", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"PUT /core/user-storage/v2/file": {
+		{Name: "base64-encoded", Type: "boolean", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"POST /core/user-storage/v2/file": {
+		{Name: "base64-encoded", Type: "boolean", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"DELETE /core/user-storage/v2/file": {
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/user-storage/v2/shared/directory": {
+		{Name: "base64-encode", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"DELETE /core/user-storage/v2/shared/directory": {
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/user-storage/v2/shared/file": {
+		{Name: "base64-encode", Type: "boolean", Style: "form", Explode: true},
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+	"PUT /core/user-storage/v2/shared/file": {
+		{Name: "base64-encoded", Type: "boolean", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"POST /core/user-storage/v2/shared/file": {
+		{Name: "base64-encoded", Type: "boolean", Style: "form", Explode: true},
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"DELETE /core/user-storage/v2/shared/file": {
+		{Name: "path", Type: "string", Style: "form", Explode: true},
+	},
+	"GET /core/workflows/v1": {
+		{Name: "eventclient", Type: "string", Style: "form", Explode: true},
+		{Name: "fields", Type: "string", Style: "form", Explode: true},
+		{Name: "filter", Type: "string", Style: "form", Explode: true},
+		{Name: "label-selector", Type: "string", Style: "form", Explode: true},
+		{Name: "labelSelector", Type: "string", Style: "form", Explode: true},
+		{Name: "stream", Type: "string", Style: "form", Explode: true},
+	},
+}
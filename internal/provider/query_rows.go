@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+// queryColumnAttrTypes are the attribute types of an element of the columns of a query data source.
+var queryColumnAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"type": types.StringType,
+}
+
+// queryColumn is a field of the rows returned by a query, with its JSON schema type.
+type queryColumn struct {
+	Name string `tfsdk:"name"`
+	Type string `tfsdk:"type"`
+}
+
+// queryResultRows returns the columns and the typed rows of a query result, from its data
+// and jsonSchema. Every row has one attribute per column, null when the row has no value.
+func queryResultRows(ctx context.Context, result map[string]any) (types.List, types.Dynamic, error) {
+	jsonSchema, _ := result["jsonSchema"].(map[string]any)
+	data, _ := result["data"].([]any)
+
+	columns := queryColumns(jsonSchema, data)
+	columnsVal, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: queryColumnAttrTypes}, columns)
+	if d.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: queryColumnAttrTypes}), types.DynamicNull(),
+			fmt.Errorf("failed to build columns: %v", d)
+	}
+
+	rows := make([]map[string]attr.Value, 0, len(data))
+	for i, row := range data {
+		rowMap, _ := row.(map[string]any)
+		cells := make(map[string]attr.Value, len(columns))
+		for _, col := range columns {
+			cell, err := queryCellValue(col.Type, rowMap[col.Name])
+			if err != nil {
+				return columnsVal, types.DynamicNull(), fmt.Errorf("row %d, column %s: %w", i, col.Name, err)
+			}
+			cells[col.Name] = cell
+		}
+		rows = append(rows, cells)
+	}
+
+	// A null cell of a column without a scalar type takes the type of the column
+	// in the other rows, so the rows have the same shape when possible
+	for _, col := range columns {
+		var colType attr.Type = types.StringType
+		for _, cells := range rows {
+			if cell := cells[col.Name]; cell != nil {
+				colType = cell.Type(ctx)
+				break
+			}
+		}
+		for _, cells := range rows {
+			if cells[col.Name] == nil {
+				cells[col.Name] = nullValueOf(colType)
+			}
+		}
+	}
+
+	elemTypes := make([]attr.Type, 0, len(rows))
+	elems := make([]attr.Value, 0, len(rows))
+	for _, cells := range rows {
+		attrTypes := make(map[string]attr.Type, len(cells))
+		for name, cell := range cells {
+			attrTypes[name] = cell.Type(ctx)
+		}
+		rowVal, d := types.ObjectValue(attrTypes, cells)
+		if d.HasError() {
+			return columnsVal, types.DynamicNull(), fmt.Errorf("failed to build row: %v", d)
+		}
+		elemTypes = append(elemTypes, rowVal.Type(ctx))
+		elems = append(elems, rowVal)
+	}
+	rowsVal, d := types.TupleValue(elemTypes, elems)
+	if d.HasError() {
+		return columnsVal, types.DynamicNull(), fmt.Errorf("failed to build rows: %v", d)
+	}
+	return columnsVal, types.DynamicValue(rowsVal), nil
+}
+
+// queryColumns returns the columns described by the JSON schema of a query result, sorted by name.
+// When the schema does not describe the rows, the columns are the fields found in the rows.
+func queryColumns(jsonSchema map[string]any, data []any) []queryColumn {
+	columns := []queryColumn{}
+	if props := jsonSchemaRowProperties(jsonSchema); len(props) > 0 {
+		for name, prop := range props {
+			propMap, _ := prop.(map[string]any)
+			columns = append(columns, queryColumn{Name: name, Type: jsonSchemaType(propMap)})
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, row := range data {
+			rowMap, _ := row.(map[string]any)
+			for name := range rowMap {
+				if !seen[name] {
+					seen[name] = true
+					columns = append(columns, queryColumn{Name: name})
+				}
+			}
+		}
+	}
+	sort.Slice(columns, func(i, j int) bool { return columns[i].Name < columns[j].Name })
+	return columns
+}
+
+// jsonSchemaRowProperties returns the properties of a row, the schema either describes
+// a row, the array of rows, or an object holding the array of rows in its data property.
+func jsonSchemaRowProperties(schema map[string]any) map[string]any {
+	for schema != nil {
+		if items, ok := schema["items"].(map[string]any); ok {
+			schema = items
+			continue
+		}
+		props, _ := schema["properties"].(map[string]any)
+		if data, ok := props["data"].(map[string]any); ok && jsonSchemaType(data) == "array" {
+			schema = data
+			continue
+		}
+		return props
+	}
+	return nil
+}
+
+// jsonSchemaType returns the type of a JSON schema, the first non null one if it has several.
+func jsonSchemaType(schema map[string]any) string {
+	switch t := schema["type"].(type) {
+	case string:
+		return t
+	case []any:
+		for _, tt := range t {
+			if s, _ := tt.(string); s != "" && s != "null" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// queryCellValue converts the value of a cell to the Terraform type of its column, scalars given
+// as strings are parsed. A null cell of a column without a scalar type is returned as nil.
+func queryCellValue(colType string, val any) (attr.Value, error) {
+	switch colType {
+	case "string":
+		switch v := val.(type) {
+		case nil:
+			return types.StringNull(), nil
+		case string:
+			return types.StringValue(v), nil
+		case bool, json.Number, float64:
+			return types.StringValue(fmt.Sprint(v)), nil
+		}
+	case "integer", "number":
+		if val == nil {
+			return types.NumberNull(), nil
+		}
+		if f, err := tfutils.NumToBigFloat(val); err == nil {
+			return types.NumberValue(f), nil
+		}
+	case "boolean":
+		switch v := val.(type) {
+		case nil:
+			return types.BoolNull(), nil
+		case bool:
+			return types.BoolValue(v), nil
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return types.BoolValue(b), nil
+			}
+		}
+	}
+	if val == nil {
+		return nil, nil
+	}
+	dynVal, err := tfutils.AnyToDynamic(val)
+	if err != nil {
+		return nil, err
+	}
+	return dynVal.UnderlyingValue(), nil
+}
+
+// nullValueOf returns a null value of the given type.
+func nullValueOf(t attr.Type) attr.Value {
+	switch tt := t.(type) {
+	case basetypes.ObjectType:
+		return types.ObjectNull(tt.AttrTypes)
+	case basetypes.TupleType:
+		return types.TupleNull(tt.ElemTypes)
+	case basetypes.ListType:
+		return types.ListNull(tt.ElemType)
+	case basetypes.MapType:
+		return types.MapNull(tt.ElemType)
+	case basetypes.NumberType:
+		return types.NumberNull()
+	case basetypes.BoolType:
+		return types.BoolNull()
+	}
+	return types.StringNull()
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+func TestQueryColumns(t *testing.T) {
+	rowSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name":  map[string]any{"type": "string"},
+			"mtu":   map[string]any{"type": []any{"null", "integer"}},
+			"state": map[string]any{"type": "object"},
+		},
+	}
+	want := []queryColumn{{Name: "mtu", Type: "integer"}, {Name: "name", Type: "string"}, {Name: "state", Type: "object"}}
+
+	tests := []struct {
+		name       string
+		jsonSchema map[string]any
+		data       []any
+		want       []queryColumn
+	}{
+		{
+			name:       "row schema",
+			jsonSchema: rowSchema,
+			want:       want,
+		},
+		{
+			name:       "array of rows",
+			jsonSchema: map[string]any{"type": "array", "items": rowSchema},
+			want:       want,
+		},
+		{
+			name: "rows in the data property",
+			jsonSchema: map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"data": map[string]any{"type": "array", "items": rowSchema}},
+			},
+			want: want,
+		},
+		{
+			name: "fields of the rows without a schema",
+			data: []any{
+				map[string]any{"name": "leaf1", "mtu": json.Number("9000")},
+				map[string]any{"name": "leaf2", "state": map[string]any{"up": true}},
+			},
+			want: []queryColumn{{Name: "mtu"}, {Name: "name"}, {Name: "state"}},
+		},
+		{
+			name: "no rows",
+			want: []queryColumn{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := queryColumns(tt.jsonSchema, tt.data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("queryColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryCellValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		colType string
+		val     any
+		want    attr.Value
+		wantErr bool
+	}{
+		{name: "string", colType: "string", val: "leaf1", want: types.StringValue("leaf1")},
+		{name: "number as a string", colType: "string", val: json.Number("42"), want: types.StringValue("42")},
+		{name: "null string", colType: "string", val: nil, want: types.StringNull()},
+		{name: "integer", colType: "integer", val: json.Number("9000"), want: types.NumberValue(big.NewFloat(9000))},
+		{name: "integer given as a string", colType: "integer", val: "9000", want: types.NumberValue(big.NewFloat(9000))},
+		{name: "null number", colType: "number", val: nil, want: types.NumberNull()},
+		{name: "boolean given as a string", colType: "boolean", val: "true", want: types.BoolValue(true)},
+		{name: "null boolean", colType: "boolean", val: nil, want: types.BoolNull()},
+		{name: "null object", colType: "object", val: nil, want: nil},
+		{name: "column without a type", colType: "", val: true, want: types.BoolValue(true)},
+		{
+			name:    "object",
+			colType: "object",
+			val:     map[string]any{"up": true},
+			want:    types.ObjectValueMust(map[string]attr.Type{"up": types.BoolType}, map[string]attr.Value{"up": types.BoolValue(true)}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := queryCellValue(tt.colType, tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("queryCellValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("queryCellValue() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || !got.Equal(tt.want) {
+				t.Errorf("queryCellValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryResultRows(t *testing.T) {
+	ctx := context.Background()
+	stateType := types.ObjectType{AttrTypes: map[string]attr.Type{"oper": types.StringType, "counters": types.ObjectType{AttrTypes: map[string]attr.Type{"in": types.NumberType}}}}
+
+	tests := []struct {
+		name        string
+		result      map[string]any
+		wantColumns []queryColumn
+		// wantRows are the rows as decoded by DynamicToAny, which leaves out null cells
+		wantRows any
+		// wantTypes are the types of the cells, by column, checked in every row
+		wantTypes map[string]attr.Type
+	}{
+		{
+			name: "nested objects and missing columns",
+			result: map[string]any{
+				"jsonSchema": map[string]any{
+					"type": "array",
+					"items": map[string]any{"properties": map[string]any{
+						"name":  map[string]any{"type": "string"},
+						"mtu":   map[string]any{"type": "integer"},
+						"state": map[string]any{"type": "object"},
+					}},
+				},
+				"data": []any{
+					map[string]any{"name": "leaf1", "mtu": json.Number("9000"), "state": map[string]any{
+						"oper": "up", "counters": map[string]any{"in": json.Number("12")},
+					}},
+					map[string]any{"name": "leaf2"},
+				},
+			},
+			wantColumns: []queryColumn{{Name: "mtu", Type: "integer"}, {Name: "name", Type: "string"}, {Name: "state", Type: "object"}},
+			wantRows: []any{
+				map[string]any{"name": "leaf1", "mtu": int64(9000), "state": map[string]any{"oper": "up", "counters": map[string]any{"in": int64(12)}}},
+				map[string]any{"name": "leaf2"},
+			},
+			wantTypes: map[string]attr.Type{"name": types.StringType, "mtu": types.NumberType, "state": stateType},
+		},
+		{
+			name: "columns of the rows without a schema",
+			result: map[string]any{
+				"data": []any{
+					map[string]any{"name": "leaf1"},
+					map[string]any{"state": map[string]any{"oper": "down", "counters": map[string]any{"in": json.Number("0")}}},
+				},
+			},
+			wantColumns: []queryColumn{{Name: "name"}, {Name: "state"}},
+			wantRows: []any{
+				map[string]any{"name": "leaf1"},
+				map[string]any{"state": map[string]any{"oper": "down", "counters": map[string]any{"in": int64(0)}}},
+			},
+			wantTypes: map[string]attr.Type{"name": types.StringType, "state": stateType},
+		},
+		{
+			name: "column null in every row",
+			result: map[string]any{
+				"jsonSchema": map[string]any{"properties": map[string]any{"state": map[string]any{"type": "object"}}},
+				"data":       []any{map[string]any{}},
+			},
+			wantColumns: []queryColumn{{Name: "state", Type: "object"}},
+			wantRows:    []any{map[string]any{}},
+			wantTypes:   map[string]attr.Type{"state": types.StringType},
+		},
+		{
+			name:        "no rows",
+			result:      map[string]any{},
+			wantColumns: []queryColumn{},
+			wantRows:    []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columnsVal, rowsVal, err := queryResultRows(ctx, tt.result)
+			if err != nil {
+				t.Fatalf("queryResultRows() error = %v", err)
+			}
+
+			columns := []queryColumn{}
+			if d := columnsVal.ElementsAs(ctx, &columns, false); d.HasError() {
+				t.Fatalf("ElementsAs() diagnostics = %v", d)
+			}
+			if !reflect.DeepEqual(columns, tt.wantColumns) {
+				t.Errorf("queryResultRows() columns = %v, want %v", columns, tt.wantColumns)
+			}
+
+			rows, err := tfutils.DynamicToAny(ctx, rowsVal)
+			if err != nil {
+				t.Fatalf("DynamicToAny() error = %v", err)
+			}
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("queryResultRows() rows = %#v, want %#v", rows, tt.wantRows)
+			}
+
+			// Every row has a cell per column, null cells take the type of the column
+			for i, row := range rowsVal.UnderlyingValue().(types.Tuple).Elements() {
+				cells := row.(types.Object).Attributes()
+				if len(cells) != len(tt.wantColumns) {
+					t.Errorf("queryResultRows() row %d has %d cells, want %d", i, len(cells), len(tt.wantColumns))
+				}
+				for name, cell := range cells {
+					if got := cell.Type(ctx); !got.Equal(tt.wantTypes[name]) {
+						t.Errorf("queryResultRows() row %d, column %s type = %s, want %s", i, name, got, tt.wantTypes[name])
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+)
+
+const (
+	read_queryParseEql   = "/core/query/v1/parse/eql"
+	read_queryParseWhere = "/core/query/v1/parse/where"
+	read_queryConvert    = "/core/query/v1/convert"
+)
+
+// queryCheck checks the syntax of a query input through the API. When the provider is not
+// configured yet, the local grammar is used if there is one.
+//
+// There is no parse request for NQL, and running the query on every plan is too costly, so NQL
+// queries are only checked when they are read.
+type queryCheck struct {
+	what    string
+	path    string
+	bodyKey string
+	local   func(string) error
+}
+
+var (
+	eqlQueryCheck = queryCheck{what: "EQL query", path: read_queryParseEql, bodyKey: "eqlString", local: parseEql}
+	eqlWhereCheck = queryCheck{what: "EQL where clause", path: read_queryParseWhere, bodyKey: "whereString", local: parseEqlWhere}
+	jsPathCheck   = queryCheck{what: "JS path", path: read_queryConvert, bodyKey: "jsPath", local: parseJsPath}
+)
+
+// queryNotCheckedError reports why an input could not be checked. It is not a syntax error.
+type queryNotCheckedError struct {
+	Reason error
+}
+
+func (e *queryNotCheckedError) Error() string {
+	return "not checked: " + e.Reason.Error()
+}
+
+func (e *queryNotCheckedError) Unwrap() error {
+	return e.Reason
+}
+
+// Check returns the syntax error of input, if any. Without a client the local grammar is
+// used, or the input is left to Read if there is none. When the API cannot be reached, or
+// rejects the request for another reason than the input, or the local grammar does not know
+// an operator of the input, a *queryNotCheckedError is returned.
+func (c queryCheck) Check(ctx context.Context, client *apiclient.EdaApiClient, input string) error {
+	if client == nil {
+		if c.local == nil {
+			return nil
+		}
+		err := c.local(input)
+		var unknownErr *eqlUnknownOperatorError
+		if errors.As(err, &unknownErr) {
+			return &queryNotCheckedError{Reason: err}
+		}
+		return err
+	}
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := client.Create(ctx, c.path, nil, map[string]any{c.bodyKey: input}, &result)
+
+	tflog.Info(ctx, "queryCheck()::API returned", map[string]any{
+		"path":      c.path,
+		"input":     input,
+		"error":     err,
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	var apiErr *apiclient.ApiError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest:
+		return errors.New(apiErrorMessage(apiErr))
+	}
+	return &queryNotCheckedError{Reason: err}
+}
+
+// validateQueryAttribute reports a syntax error in the string attribute at p as an attribute
+// error, and an input that could not be checked as an attribute warning. Null values, and
+// values not known during plan, are not checked.
+func validateQueryAttribute(ctx context.Context, client *apiclient.EdaApiClient, check queryCheck, config tfsdk.Config, p path.Path, diags *diag.Diagnostics) {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, p, &value)...)
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	err := check.Check(ctx, client, value.ValueString())
+	var notChecked *queryNotCheckedError
+	switch {
+	case err == nil:
+	case errors.As(err, &notChecked):
+		diags.AddAttributeWarning(p, check.what+" not checked", fmt.Sprintf("The syntax of %q could not be checked during plan, errors are reported when it is read: %s", value.ValueString(), notChecked.Reason))
+	default:
+		diags.AddAttributeError(p, "Invalid "+check.what, fmt.Sprintf("%q is not a valid %s: %s", value.ValueString(), check.what, err))
+	}
+}
+
+// validateLabelSelectorAttribute reports a syntax error in the label selector attribute at p.
+func validateLabelSelectorAttribute(ctx context.Context, config tfsdk.Config, p path.Path, diags *diag.Diagnostics) {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, p, &value)...)
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	if _, err := parseLabelSelector(value.ValueString()); err != nil {
+		diags.AddAttributeError(p, "Invalid label selector", err.Error())
+	}
+}
+
+// apiErrorMessage returns the message of an ErrorResponse body, or the raw body if it is not one.
+func apiErrorMessage(apiErr *apiclient.ApiError) string {
+	var errResp struct {
+		Message     string `json:"message"`
+		CauseSimple string `json:"causeSimple"`
+	}
+	if json.Unmarshal([]byte(apiErr.Body), &errResp) != nil || errResp.Message == "" {
+		return apiErr.Body
+	}
+	if errResp.CauseSimple != "" {
+		return errResp.Message + ": " + errResp.CauseSimple
+	}
+	return errResp.Message
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestQueryCheckWithoutClient(t *testing.T) {
+	tests := []struct {
+		name           string
+		check          queryCheck
+		input          string
+		wantErr        bool
+		wantNotChecked bool
+	}{
+		{
+			name:  "valid where clause",
+			check: eqlWhereCheck,
+			input: `oper-state = "down"`,
+		},
+		{
+			name:    "invalid where clause",
+			check:   eqlWhereCheck,
+			input:   `oper-state = `,
+			wantErr: true,
+		},
+		{
+			name:           "unknown operator",
+			check:          eqlQueryCheck,
+			input:          `.namespace.node where (name !~ "leaf.*")`,
+			wantErr:        true,
+			wantNotChecked: true,
+		},
+		{
+			name:    "invalid JS path",
+			check:   jsPathCheck,
+			input:   "namespace.node",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check.Check(context.Background(), nil, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			var notChecked *queryNotCheckedError
+			if errors.As(err, &notChecked) != tt.wantNotChecked {
+				t.Errorf("Check(%q) error = %v, want not checked %v", tt.input, err, tt.wantNotChecked)
+			}
+		})
+	}
+}
@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_store_app_settings_definition"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewStoreAppSettingsDefinitionDataSource() datasource.DataSource {
 }
 
 type storeAppSettingsDefinitionDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// storeAppSettingsDefinitionDataSourceModel is the model of the data source with the extra attribute.
+type storeAppSettingsDefinitionDataSourceModel struct {
+	datasource_store_app_settings_definition.StoreAppSettingsDefinitionModel
+	tfutils.ExtraModel
 }
 
 func (d *storeAppSettingsDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *storeAppSettingsDefinitionDataSource) Metadata(ctx context.Context, req
 }
 
 func (d *storeAppSettingsDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_store_app_settings_definition.StoreAppSettingsDefinitionDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_store_app_settings_definition.StoreAppSettingsDefinitionDataSourceSchema(ctx))
 }
 
 func (d *storeAppSettingsDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_store_app_settings_definition.StoreAppSettingsDefinitionModel
+	var data storeAppSettingsDefinitionDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *storeAppSettingsDefinitionDataSource) Read(ctx context.Context, req dat
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.StoreAppSettingsDefinitionModel, oasQueryParams(http.MethodGet, read_ds_storeAppSettingsDefinition))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -79,7 +87,12 @@ func (d *storeAppSettingsDefinitionDataSource) Read(ctx context.Context, req dat
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_storeAppSettingsDefinition)
+	err = decoder.AnyMapToModel(ctx, result, &data.StoreAppSettingsDefinitionModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.StoreAppSettingsDefinitionModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -97,14 +110,14 @@ func (r *storeAppSettingsDefinitionDataSource) Configure(_ context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -3,21 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_stream_result"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
 const read_ds_streamResult = "/core/query/v1"
 
 var (
-	_ datasource.DataSource              = (*streamResultDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*streamResultDataSource)(nil)
+	_ datasource.DataSource                   = (*streamResultDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*streamResultDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*streamResultDataSource)(nil)
 )
 
 func NewStreamResultDataSource() datasource.DataSource {
@@ -25,7 +27,7 @@ func NewStreamResultDataSource() datasource.DataSource {
 }
 
 type streamResultDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *streamResultDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +35,11 @@ func (d *streamResultDataSource) Metadata(ctx context.Context, req datasource.Me
 }
 
 func (d *streamResultDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_stream_result.StreamResultDataSourceSchema(ctx)
+	resp.Schema = datasource_stream_result.StreamResultCustomDataSourceSchema(ctx)
 }
 
 func (d *streamResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_stream_result.StreamResultModel
+	var data datasource_stream_result.StreamResultCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +49,7 @@ func (d *streamResultDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data, oasQueryParams(http.MethodGet, read_ds_streamResult))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,7 +78,11 @@ func (d *streamResultDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	err = d.oasDecoder(http.MethodGet, read_ds_streamResult).AnyMapToModel(ctx, result, &data)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Columns, data.Rows, err = queryResultRows(ctx, result)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -86,6 +92,11 @@ func (d *streamResultDataSource) Read(ctx context.Context, req datasource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ValidateConfig checks the syntax of the query inputs during plan.
+func (d *streamResultDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateQueryAttribute(ctx, d.client, eqlQueryCheck, req.Config, path.Root("query"), &resp.Diagnostics)
+}
+
 // Configure adds the provider configured client to the data source.
 func (r *streamResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -94,14 +105,14 @@ func (r *streamResultDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_topology_grouping_instance"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewTopologyGroupingInstanceDataSource() datasource.DataSource {
 }
 
 type topologyGroupingInstanceDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// topologyGroupingInstanceDataSourceModel is the model of the data source with the extra attribute.
+type topologyGroupingInstanceDataSourceModel struct {
+	datasource_topology_grouping_instance.TopologyGroupingInstanceModel
+	tfutils.ExtraModel
 }
 
 func (d *topologyGroupingInstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *topologyGroupingInstanceDataSource) Metadata(ctx context.Context, req d
 }
 
 func (d *topologyGroupingInstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_topology_grouping_instance.TopologyGroupingInstanceDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_topology_grouping_instance.TopologyGroupingInstanceDataSourceSchema(ctx))
 }
 
 func (d *topologyGroupingInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_topology_grouping_instance.TopologyGroupingInstanceModel
+	var data topologyGroupingInstanceDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *topologyGroupingInstanceDataSource) Read(ctx context.Context, req datas
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.TopologyGroupingInstanceModel, oasQueryParams(http.MethodGet, read_ds_topologyGroupingInstance))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -79,7 +87,12 @@ func (d *topologyGroupingInstanceDataSource) Read(ctx context.Context, req datas
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_topologyGroupingInstance)
+	err = decoder.AnyMapToModel(ctx, result, &data.TopologyGroupingInstanceModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.TopologyGroupingInstanceModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -97,14 +110,14 @@ func (r *topologyGroupingInstanceDataSource) Configure(_ context.Context, req da
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
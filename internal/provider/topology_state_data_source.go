@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_topology_state"
+)
+
+const read_ds_topologyState = "/core/topology/v1/{topologyName}/state"
+
+var (
+	_ datasource.DataSource              = (*topologyStateDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*topologyStateDataSource)(nil)
+)
+
+func NewTopologyStateDataSource() datasource.DataSource {
+	return &topologyStateDataSource{}
+}
+
+type topologyStateDataSource struct {
+	providerData
+}
+
+// topoOverlayState is the state of a node, link or endpoint in an overlay, as returned by the API.
+type topoOverlayState struct {
+	Badges []int64 `json:"badges"`
+	State  int64   `json:"state"`
+}
+
+type topoEndpoint struct {
+	GroupKey string                      `json:"group_key"`
+	Name     string                      `json:"name"`
+	Node     string                      `json:"node"`
+	NodeKey  string                      `json:"node_key"`
+	Overlays map[string]topoOverlayState `json:"overlays"`
+}
+
+type topoGrouping struct {
+	Group       string `json:"group"`
+	GroupKey    string `json:"group_key"`
+	GroupUiName string `json:"group_ui_name"`
+	Tier        int64  `json:"tier"`
+}
+
+// topoElement is a node, node group, link or link group. The UI fields of the elements
+// (ui_name, schema and attributes) are not decoded.
+type topoElement struct {
+	CrName    string                      `json:"cr_name"`
+	EndpointA topoEndpoint                `json:"endpoint_a"`
+	EndpointB topoEndpoint                `json:"endpoint_b"`
+	GroupKey  string                      `json:"group_key"`
+	Grouping  topoGrouping                `json:"grouping"`
+	Key       string                      `json:"key"`
+	Labels    map[string]string           `json:"labels"`
+	Name      string                      `json:"name"`
+	Namespace string                      `json:"namespace"`
+	NumNodes  int64                       `json:"num_nodes"`
+	Overlays  map[string]topoOverlayState `json:"overlays"`
+	Type      string                      `json:"type"`
+}
+
+type topoState struct {
+	LinkGroups     map[string]topoElement `json:"link_groups"`
+	Links          map[string]topoElement `json:"links"`
+	Nodes          map[string]topoElement `json:"nodes"`
+	NodesAndGroups map[string]topoElement `json:"nodes_and_groups"`
+}
+
+func (d *topologyStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topology_state"
+}
+
+func (d *topologyStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_topology_state.TopologyStateDataSourceSchema(ctx)
+}
+
+func (d *topologyStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_topology_state.TopologyStateModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overlays, nodeSelector, linkSelector []string
+	resp.Diagnostics.Append(data.Overlays.ElementsAs(ctx, &overlays, false)...)
+	resp.Diagnostics.Append(data.NodeSelector.ElementsAs(ctx, &nodeSelector, false)...)
+	resp.Diagnostics.Append(data.LinkSelector.ElementsAs(ctx, &linkSelector, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := map[string]any{}
+	if !data.Namespace.IsNull() {
+		reqBody["namespace"] = data.Namespace.ValueString()
+	}
+	if len(overlays) > 0 {
+		reqBody["overlays"] = overlays
+	}
+	filter := map[string]any{}
+	if len(nodeSelector) > 0 {
+		filter["node_filter"] = map[string]any{"label": map[string]any{"selector": nodeSelector}}
+	}
+	if len(linkSelector) > 0 {
+		filter["link_filter"] = map[string]any{"label": map[string]any{"selector": linkSelector}}
+	}
+	if len(filter) > 0 {
+		reqBody["filter"] = filter
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": read_ds_topologyState,
+		"body": spew.Sdump(reqBody),
+	})
+
+	t0 := time.Now()
+	result := topoState{}
+	err := d.client.Create(ctx, read_ds_topologyState, map[string]string{
+		"topologyName": data.TopologyName.ValueString(),
+	}, reqBody, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      read_ds_topologyState,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model
+	resp.Diagnostics.Append(setTopoState(ctx, &data, result)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setTopoState sets the nodes, node groups, links and link groups of the topology state
+// returned by the API to data, keyed as in the API response.
+func setTopoState(ctx context.Context, data *datasource_topology_state.TopologyStateModel, result topoState) diag.Diagnostics {
+	var diags diag.Diagnostics
+	nodes := map[string]datasource_topology_state.NodeModel{}
+	for key, node := range result.Nodes {
+		nodes[key] = datasource_topology_state.NodeModel{
+			CrName:    types.StringValue(node.CrName),
+			Grouping:  topoGroupingModel(node.Grouping),
+			Key:       types.StringValue(node.Key),
+			Labels:    node.Labels,
+			Name:      types.StringValue(node.Name),
+			Namespace: types.StringValue(node.Namespace),
+			Overlays:  topoOverlayStates(node.Overlays),
+		}
+	}
+	nodesAndGroups := map[string]datasource_topology_state.NodeOrGroupModel{}
+	for key, node := range result.NodesAndGroups {
+		nodesAndGroups[key] = datasource_topology_state.NodeOrGroupModel{
+			CrName:    types.StringValue(node.CrName),
+			Grouping:  topoGroupingModel(node.Grouping),
+			Key:       types.StringValue(node.Key),
+			Labels:    node.Labels,
+			Name:      types.StringValue(node.Name),
+			Namespace: types.StringValue(node.Namespace),
+			NumNodes:  types.Int64Value(node.NumNodes),
+			Overlays:  topoOverlayStates(node.Overlays),
+			Type:      types.StringValue(node.Type),
+		}
+	}
+	links := map[string]datasource_topology_state.LinkModel{}
+	for key, link := range result.Links {
+		links[key] = datasource_topology_state.LinkModel{
+			CrName:    types.StringValue(link.CrName),
+			EndpointA: topoEndpointModel(link.EndpointA),
+			EndpointB: topoEndpointModel(link.EndpointB),
+			GroupKey:  types.StringValue(link.GroupKey),
+			Key:       types.StringValue(link.Key),
+			Labels:    link.Labels,
+			Name:      types.StringValue(link.Name),
+			Namespace: types.StringValue(link.Namespace),
+			Overlays:  topoOverlayStates(link.Overlays),
+		}
+	}
+
+	linkGroups := map[string]datasource_topology_state.LinkGroupModel{}
+	for key, group := range result.LinkGroups {
+		linkGroups[key] = datasource_topology_state.LinkGroupModel{
+			EndpointA: topoLinkGroupEndpointModel(group.EndpointA),
+			EndpointB: topoLinkGroupEndpointModel(group.EndpointB),
+			Key:       types.StringValue(group.Key),
+			Overlays:  topoOverlayStates(group.Overlays),
+		}
+	}
+
+	nodesVal, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: datasource_topology_state.NodeAttrTypes}, nodes)
+	diags.Append(d...)
+	nodesAndGroupsVal, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: datasource_topology_state.NodeOrGroupAttrTypes}, nodesAndGroups)
+	diags.Append(d...)
+	linksVal, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: datasource_topology_state.LinkAttrTypes}, links)
+	diags.Append(d...)
+	linkGroupsVal, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: datasource_topology_state.LinkGroupAttrTypes}, linkGroups)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+	data.Nodes = nodesVal
+	data.NodesAndGroups = nodesAndGroupsVal
+	data.Links = linksVal
+	data.LinkGroups = linkGroupsVal
+	return diags
+}
+
+func topoEndpointModel(ep topoEndpoint) datasource_topology_state.EndpointModel {
+	return datasource_topology_state.EndpointModel{
+		Name:     types.StringValue(ep.Name),
+		Node:     types.StringValue(ep.Node),
+		NodeKey:  types.StringValue(ep.NodeKey),
+		Overlays: topoOverlayStates(ep.Overlays),
+	}
+}
+
+func topoLinkGroupEndpointModel(ep topoEndpoint) datasource_topology_state.LinkGroupEndpointModel {
+	return datasource_topology_state.LinkGroupEndpointModel{
+		GroupKey: types.StringValue(ep.GroupKey),
+		NodeKey:  types.StringValue(ep.NodeKey),
+	}
+}
+
+func topoGroupingModel(grouping topoGrouping) datasource_topology_state.GroupingModel {
+	return datasource_topology_state.GroupingModel{
+		Group:       types.StringValue(grouping.Group),
+		GroupKey:    types.StringValue(grouping.GroupKey),
+		GroupUiName: types.StringValue(grouping.GroupUiName),
+		Tier:        types.Int64Value(grouping.Tier),
+	}
+}
+
+func topoOverlayStates(overlays map[string]topoOverlayState) map[string]datasource_topology_state.OverlayStateModel {
+	if overlays == nil {
+		return nil
+	}
+	states := make(map[string]datasource_topology_state.OverlayStateModel, len(overlays))
+	for name, state := range overlays {
+		states[name] = datasource_topology_state.OverlayStateModel{
+			Badges: state.Badges,
+			State:  types.Int64Value(state.State),
+		}
+	}
+	return states
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *topologyStateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_topology_state"
+)
+
+// topoTestResponse is a topology state as returned by the API, with a node group of two
+// leaves, and the link and the link group between a spine and the leaves.
+const topoTestResponse = `{
+	"nodes": {
+		"eda/leaf1": {
+			"cr_name": "leaf1", "key": "eda/leaf1", "name": "leaf1", "namespace": "eda",
+			"labels": {"eda.nokia.com/role": "leaf"},
+			"grouping": {"group": "leaf", "group_key": "leaf-group", "group_ui_name": "Leafs", "tier": 2},
+			"overlays": {"alarms": {"state": 2, "badges": [1, 3]}},
+			"ui_name": "Leaf 1"
+		},
+		"eda/spine1": {
+			"cr_name": "spine1", "key": "eda/spine1", "name": "spine1", "namespace": "eda",
+			"grouping": {"group": "spine", "group_key": "spine-group", "group_ui_name": "Spines", "tier": 1}
+		}
+	},
+	"nodes_and_groups": {
+		"leaf-group": {
+			"key": "leaf-group", "name": "leaf", "type": "group", "num_nodes": 2,
+			"grouping": {"group": "leaf", "group_key": "leaf-group", "group_ui_name": "Leafs", "tier": 2}
+		}
+	},
+	"links": {
+		"eda/spine1-leaf1": {
+			"cr_name": "spine1-leaf1", "key": "eda/spine1-leaf1", "name": "spine1-leaf1", "namespace": "eda",
+			"group_key": "spine-group/leaf-group",
+			"endpoint_a": {"name": "ethernet-1-1", "node": "spine1", "node_key": "eda/spine1", "group_key": "spine-group"},
+			"endpoint_b": {"name": "ethernet-1-49", "node": "leaf1", "node_key": "eda/leaf1", "group_key": "leaf-group",
+				"overlays": {"utilization": {"state": 1}}}
+		}
+	},
+	"link_groups": {
+		"spine-group/leaf-group": {
+			"key": "spine-group/leaf-group",
+			"endpoint_a": {"group_key": "spine-group", "node_key": "eda/spine1"},
+			"endpoint_b": {"group_key": "leaf-group"},
+			"overlays": {"utilization": {"state": 1, "badges": []}}
+		}
+	}
+}`
+
+func TestSetTopoState(t *testing.T) {
+	ctx := context.Background()
+	result := topoState{}
+	if err := json.Unmarshal([]byte(topoTestResponse), &result); err != nil {
+		t.Fatalf("decoding the topology state: %v", err)
+	}
+	data := datasource_topology_state.TopologyStateModel{}
+	if diags := setTopoState(ctx, &data, result); diags.HasError() {
+		t.Fatalf("setTopoState() diagnostics = %v", diags)
+	}
+
+	leafGrouping := datasource_topology_state.GroupingModel{
+		Group:       types.StringValue("leaf"),
+		GroupKey:    types.StringValue("leaf-group"),
+		GroupUiName: types.StringValue("Leafs"),
+		Tier:        types.Int64Value(2),
+	}
+
+	nodes := map[string]datasource_topology_state.NodeModel{}
+	data.Nodes.ElementsAs(ctx, &nodes, false)
+	wantNodes := map[string]datasource_topology_state.NodeModel{
+		"eda/leaf1": {
+			CrName:    types.StringValue("leaf1"),
+			Grouping:  leafGrouping,
+			Key:       types.StringValue("eda/leaf1"),
+			Labels:    map[string]string{"eda.nokia.com/role": "leaf"},
+			Name:      types.StringValue("leaf1"),
+			Namespace: types.StringValue("eda"),
+			Overlays: map[string]datasource_topology_state.OverlayStateModel{
+				"alarms": {Badges: []int64{1, 3}, State: types.Int64Value(2)},
+			},
+		},
+		"eda/spine1": {
+			CrName: types.StringValue("spine1"),
+			Grouping: datasource_topology_state.GroupingModel{
+				Group:       types.StringValue("spine"),
+				GroupKey:    types.StringValue("spine-group"),
+				GroupUiName: types.StringValue("Spines"),
+				Tier:        types.Int64Value(1),
+			},
+			Key:       types.StringValue("eda/spine1"),
+			Name:      types.StringValue("spine1"),
+			Namespace: types.StringValue("eda"),
+		},
+	}
+	if !reflect.DeepEqual(nodes, wantNodes) {
+		t.Errorf("setTopoState() nodes = %+v, want %+v", nodes, wantNodes)
+	}
+
+	groups := map[string]datasource_topology_state.NodeOrGroupModel{}
+	data.NodesAndGroups.ElementsAs(ctx, &groups, false)
+	wantGroups := map[string]datasource_topology_state.NodeOrGroupModel{
+		"leaf-group": {
+			CrName:    types.StringValue(""),
+			Grouping:  leafGrouping,
+			Key:       types.StringValue("leaf-group"),
+			Name:      types.StringValue("leaf"),
+			Namespace: types.StringValue(""),
+			NumNodes:  types.Int64Value(2),
+			Type:      types.StringValue("group"),
+		},
+	}
+	if !reflect.DeepEqual(groups, wantGroups) {
+		t.Errorf("setTopoState() nodes_and_groups = %+v, want %+v", groups, wantGroups)
+	}
+
+	links := map[string]datasource_topology_state.LinkModel{}
+	data.Links.ElementsAs(ctx, &links, false)
+	wantLinks := map[string]datasource_topology_state.LinkModel{
+		"eda/spine1-leaf1": {
+			CrName: types.StringValue("spine1-leaf1"),
+			EndpointA: datasource_topology_state.EndpointModel{
+				Name:    types.StringValue("ethernet-1-1"),
+				Node:    types.StringValue("spine1"),
+				NodeKey: types.StringValue("eda/spine1"),
+			},
+			EndpointB: datasource_topology_state.EndpointModel{
+				Name:    types.StringValue("ethernet-1-49"),
+				Node:    types.StringValue("leaf1"),
+				NodeKey: types.StringValue("eda/leaf1"),
+				Overlays: map[string]datasource_topology_state.OverlayStateModel{
+					"utilization": {State: types.Int64Value(1)},
+				},
+			},
+			GroupKey:  types.StringValue("spine-group/leaf-group"),
+			Key:       types.StringValue("eda/spine1-leaf1"),
+			Name:      types.StringValue("spine1-leaf1"),
+			Namespace: types.StringValue("eda"),
+		},
+	}
+	if !reflect.DeepEqual(links, wantLinks) {
+		t.Errorf("setTopoState() links = %+v, want %+v", links, wantLinks)
+	}
+
+	linkGroups := map[string]datasource_topology_state.LinkGroupModel{}
+	data.LinkGroups.ElementsAs(ctx, &linkGroups, false)
+	wantLinkGroups := map[string]datasource_topology_state.LinkGroupModel{
+		"spine-group/leaf-group": {
+			EndpointA: datasource_topology_state.LinkGroupEndpointModel{
+				GroupKey: types.StringValue("spine-group"),
+				NodeKey:  types.StringValue("eda/spine1"),
+			},
+			EndpointB: datasource_topology_state.LinkGroupEndpointModel{
+				GroupKey: types.StringValue("leaf-group"),
+				NodeKey:  types.StringValue(""),
+			},
+			Key: types.StringValue("spine-group/leaf-group"),
+			Overlays: map[string]datasource_topology_state.OverlayStateModel{
+				"utilization": {Badges: []int64{}, State: types.Int64Value(1)},
+			},
+		},
+	}
+	if !reflect.DeepEqual(linkGroups, wantLinkGroups) {
+		t.Errorf("setTopoState() link_groups = %+v, want %+v", linkGroups, wantLinkGroups)
+	}
+}
+
+func TestSetTopoStateEmpty(t *testing.T) {
+	ctx := context.Background()
+	data := datasource_topology_state.TopologyStateModel{}
+	if diags := setTopoState(ctx, &data, topoState{}); diags.HasError() {
+		t.Fatalf("setTopoState() diagnostics = %v", diags)
+	}
+	// An empty state gives empty maps rather than null ones
+	for name, m := range map[string]types.Map{
+		"nodes":            data.Nodes,
+		"nodes_and_groups": data.NodesAndGroups,
+		"links":            data.Links,
+		"link_groups":      data.LinkGroups,
+	} {
+		if m.IsNull() || len(m.Elements()) != 0 {
+			t.Errorf("setTopoState() %s = %v, want an empty map", name, m)
+		}
+	}
+}
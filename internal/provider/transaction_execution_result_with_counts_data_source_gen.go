@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_transaction_execution_result_with_counts"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewTransactionExecutionResultWithCountsDataSource() datasource.DataSource {
 }
 
 type transactionExecutionResultWithCountsDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// transactionExecutionResultWithCountsDataSourceModel is the model of the data source with the extra attribute.
+type transactionExecutionResultWithCountsDataSourceModel struct {
+	datasource_transaction_execution_result_with_counts.TransactionExecutionResultWithCountsModel
+	tfutils.ExtraModel
 }
 
 func (d *transactionExecutionResultWithCountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *transactionExecutionResultWithCountsDataSource) Metadata(ctx context.Co
 }
 
 func (d *transactionExecutionResultWithCountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_transaction_execution_result_with_counts.TransactionExecutionResultWithCountsDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_transaction_execution_result_with_counts.TransactionExecutionResultWithCountsDataSourceSchema(ctx))
 }
 
 func (d *transactionExecutionResultWithCountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_transaction_execution_result_with_counts.TransactionExecutionResultWithCountsModel
+	var data transactionExecutionResultWithCountsDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *transactionExecutionResultWithCountsDataSource) Read(ctx context.Contex
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.TransactionExecutionResultWithCountsModel, oasQueryParams(http.MethodGet, read_ds_transactionExecutionResultWithCounts))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -78,7 +86,12 @@ func (d *transactionExecutionResultWithCountsDataSource) Read(ctx context.Contex
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_transactionExecutionResultWithCounts)
+	err = decoder.AnyMapToModel(ctx, result, &data.TransactionExecutionResultWithCountsModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.TransactionExecutionResultWithCountsModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -96,14 +109,14 @@ func (r *transactionExecutionResultWithCountsDataSource) Configure(_ context.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
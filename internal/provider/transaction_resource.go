@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strconv"
 	"time"
@@ -11,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_transaction"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
@@ -32,7 +32,7 @@ func NewTransactionResource() resource.Resource {
 }
 
 type transactionResource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (r *transactionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,9 +61,9 @@ func (r *transactionResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Convert Terraform model to API request body
-	reqBody, err := tfutils.ModelToAnyMap(ctx, &data)
+	reqBody, err := oasConverter(http.MethodPost, create_transaction).ModelToAnyMap(ctx, &data)
 	if err != nil {
-		resp.Diagnostics.AddError("Error building request", err.Error())
+		tfutils.AddConversionError(&resp.Diagnostics, "Error building request", err)
 		return
 	}
 
@@ -175,16 +175,16 @@ func (r *transactionResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
 
 // // ImportState implements resource.ResourceWithImportState.
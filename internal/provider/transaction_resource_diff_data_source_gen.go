@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_transaction_resource_diff"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewTransactionResourceDiffDataSource() datasource.DataSource {
 }
 
 type transactionResourceDiffDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// transactionResourceDiffDataSourceModel is the model of the data source with the extra attribute.
+type transactionResourceDiffDataSourceModel struct {
+	datasource_transaction_resource_diff.TransactionResourceDiffModel
+	tfutils.ExtraModel
 }
 
 func (d *transactionResourceDiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *transactionResourceDiffDataSource) Metadata(ctx context.Context, req da
 }
 
 func (d *transactionResourceDiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_transaction_resource_diff.TransactionResourceDiffDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_transaction_resource_diff.TransactionResourceDiffDataSourceSchema(ctx))
 }
 
 func (d *transactionResourceDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_transaction_resource_diff.TransactionResourceDiffModel
+	var data transactionResourceDiffDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *transactionResourceDiffDataSource) Read(ctx context.Context, req dataso
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.TransactionResourceDiffModel, oasQueryParams(http.MethodGet, read_ds_transactionResourceDiff))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -78,7 +86,12 @@ func (d *transactionResourceDiffDataSource) Read(ctx context.Context, req dataso
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_transactionResourceDiff)
+	err = decoder.AnyMapToModel(ctx, result, &data.TransactionResourceDiffModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.TransactionResourceDiffModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -96,14 +109,14 @@ func (r *transactionResourceDiffDataSource) Configure(_ context.Context, req dat
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
@@ -3,13 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_transaction_summary_results"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +25,7 @@ func NewTransactionSummaryResultsDataSource() datasource.DataSource {
 }
 
 type transactionSummaryResultsDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *transactionSummaryResultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +33,11 @@ func (d *transactionSummaryResultsDataSource) Metadata(ctx context.Context, req
 }
 
 func (d *transactionSummaryResultsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_transaction_summary_results.TransactionSummaryResultsDataSourceSchema(ctx)
+	resp.Schema = datasource_transaction_summary_results.TransactionSummaryResultsCustomDataSourceSchema(ctx)
 }
 
 func (d *transactionSummaryResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_transaction_summary_results.TransactionSummaryResultsModel
+	var data datasource_transaction_summary_results.TransactionSummaryResultsCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +47,7 @@ func (d *transactionSummaryResultsDataSource) Read(ctx context.Context, req data
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.TransactionSummaryResultsModel, oasQueryParams(http.MethodGet, read_ds_transactionSummaryResults))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -75,10 +75,17 @@ func (d *transactionSummaryResultsDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	// Apply the client-side list options
+	items, _ := result["results"].([]any)
+	result["results"], err = data.Apply(ctx, oasConverter(http.MethodGet, read_ds_transactionSummaryResults), "results", items)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+		resp.Diagnostics.AddError("Error applying list options", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model
+	err = d.oasDecoder(http.MethodGet, read_ds_transactionSummaryResults).AnyMapToModel(ctx, result, &data.TransactionSummaryResultsModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -94,14 +101,14 @@ func (r *transactionSummaryResultsDataSource) Configure(_ context.Context, req d
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
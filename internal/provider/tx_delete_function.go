@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+var _ function.Function = (*txDeleteFunction)(nil)
+
+func NewTxDeleteFunction() function.Function {
+	return &txDeleteFunction{}
+}
+
+// txDeleteFunction builds a transaction entry that deletes a CR.
+type txDeleteFunction struct{}
+
+func (f *txDeleteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tx_delete"
+}
+
+func (f *txDeleteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a transaction entry that deletes a CR",
+		Description:         "Builds a delete entry for the crs of a transaction or a merge request, from the group, version and kind, the namespace and the name of the CR.",
+		MarkdownDescription: "Builds a `delete` entry for the `crs` of a transaction or a merge request, from the group, version and kind, the namespace and the name of the CR.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name: "gvk",
+				AttributeTypes: map[string]attr.Type{
+					"group":   types.StringType,
+					"version": types.StringType,
+					"kind":    types.StringType,
+				},
+				Description:         "The group, version and kind of the CR. The group must be given even for CRs in the core group, as \"\".",
+				MarkdownDescription: "The `group`, `version` and `kind` of the CR. The `group` must be given even for CRs in the core group, as `\"\"`.",
+			},
+			function.StringParameter{
+				Name:                "namespace",
+				AllowNullValue:      true,
+				Description:         "The namespace of the CR, null for cluster scoped CRs.",
+				MarkdownDescription: "The namespace of the CR, `null` for cluster scoped CRs.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The name of the CR.",
+				MarkdownDescription: "The name of the CR.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *txDeleteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var gvk types.Object
+	var namespace, name types.String
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &gvk, &namespace, &name))
+
+	if resp.Error != nil {
+		return
+	}
+
+	gvkVal, err := tfutils.DynamicToAny(ctx, gvk)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	gvkMap, _ := gvkVal.(map[string]any)
+	if err := validateTxGvk(gvkMap, "gvk"); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if name.ValueString() == "" {
+		resp.Error = function.NewArgumentFuncError(2, "name must be a non-empty string")
+		return
+	}
+
+	target := map[string]any{
+		"gvk":  gvkMap,
+		"name": name.ValueString(),
+	}
+	if namespace.ValueString() != "" {
+		target["namespace"] = namespace.ValueString()
+	}
+
+	txSetResult(ctx, resp, map[string]any{
+		"type": map[string]any{
+			"delete": target,
+		},
+	})
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTxDeleteFunction(t *testing.T) {
+	gvkTypes := map[string]attr.Type{
+		"group":   types.StringType,
+		"version": types.StringType,
+		"kind":    types.StringType,
+	}
+	gvk := func(group, version, kind string) attr.Value {
+		return types.ObjectValueMust(gvkTypes, map[string]attr.Value{
+			"group":   types.StringValue(group),
+			"version": types.StringValue(version),
+			"kind":    types.StringValue(kind),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		gvk       attr.Value
+		namespace types.String
+		crName    types.String
+		want      any
+		wantErr   bool
+	}{
+		{
+			name:      "namespaced CR",
+			gvk:       gvk("interfaces.eda.nokia.com", "v1alpha1", "Interface"),
+			namespace: types.StringValue("eda"),
+			crName:    types.StringValue("leaf1-ethernet-1-1"),
+			want: map[string]any{"type": map[string]any{"delete": map[string]any{
+				"gvk":       map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "kind": "Interface"},
+				"name":      "leaf1-ethernet-1-1",
+				"namespace": "eda",
+			}}},
+		},
+		{
+			name:      "cluster-scoped CR in the core group",
+			gvk:       gvk("", "v1", "Namespace"),
+			namespace: types.StringNull(),
+			crName:    types.StringValue("eda"),
+			want: map[string]any{"type": map[string]any{"delete": map[string]any{
+				"gvk":  map[string]any{"group": "", "version": "v1", "kind": "Namespace"},
+				"name": "eda",
+			}}},
+		},
+		{
+			name:      "missing kind",
+			gvk:       gvk("interfaces.eda.nokia.com", "v1alpha1", ""),
+			namespace: types.StringValue("eda"),
+			crName:    types.StringValue("leaf1-ethernet-1-1"),
+			wantErr:   true,
+		},
+		{
+			name:      "missing name",
+			gvk:       gvk("interfaces.eda.nokia.com", "v1alpha1", "Interface"),
+			namespace: types.StringValue("eda"),
+			crName:    types.StringValue(""),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runTxFunction(t, NewTxDeleteFunction(), tt.gvk, tt.namespace, tt.crName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !crValuesEqual(got, tt.want) {
+				t.Errorf("Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
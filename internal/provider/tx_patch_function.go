@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+var _ function.Function = (*txPatchFunction)(nil)
+
+// txPatchOps are the operations of a JSON patch, as in RFC 6902.
+var txPatchOps = []string{"add", "remove", "replace", "move", "copy", "test"}
+
+func NewTxPatchFunction() function.Function {
+	return &txPatchFunction{}
+}
+
+// txPatchFunction builds a transaction entry that applies a JSON patch to a CR.
+type txPatchFunction struct{}
+
+func (f *txPatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tx_patch"
+}
+
+func (f *txPatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a transaction entry that patches a CR",
+		Description:         "Builds a patch entry for the crs of a transaction or a merge request, that applies JSON patch operations (RFC 6902) to an existing CR.",
+		MarkdownDescription: "Builds a `patch` entry for the `crs` of a transaction or a merge request, that applies JSON patch operations (RFC 6902) to an existing CR.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "target",
+				Description:         "The CR to patch, an object with gvk (group, version and kind), name and, for namespaced CRs, namespace. The group must be given even for CRs in the core group, as \"\".",
+				MarkdownDescription: "The CR to patch, an object with `gvk` (`group`, `version` and `kind`), `name` and, for namespaced CRs, `namespace`. The `group` must be given even for CRs in the core group, as `\"\"`.",
+			},
+			function.DynamicParameter{
+				Name:                "ops",
+				Description:         "The patch operations, a list of objects with op, path and, depending on the operation, value, from and x-permissive.",
+				MarkdownDescription: "The patch operations, a list of objects with `op`, `path` and, depending on the operation, `value`, `from` and `x-permissive`.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *txPatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var target, ops types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &target, &ops))
+
+	if resp.Error != nil {
+		return
+	}
+
+	targetVal, err := tfutils.DynamicToAny(ctx, target)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	targetMap, ok := targetVal.(map[string]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "target must be an object")
+		return
+	}
+	gvk, ok := targetMap["gvk"].(map[string]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "target.gvk must be an object")
+		return
+	}
+	if err := validateTxGvk(gvk, "target.gvk"); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if s, _ := targetMap["name"].(string); s == "" {
+		resp.Error = function.NewArgumentFuncError(0, "target.name must be a non-empty string")
+		return
+	}
+
+	opsVal, err := tfutils.DynamicToAny(ctx, ops)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	opsList, ok := opsVal.([]any)
+	if !ok || len(opsList) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "ops must be a non-empty list of patch operations")
+		return
+	}
+	for i, op := range opsList {
+		if err := validateTxPatchOp(op); err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("ops[%d]: %s", i, err))
+			return
+		}
+	}
+
+	txSetResult(ctx, resp, map[string]any{
+		"type": map[string]any{
+			"patch": map[string]any{
+				"target":   targetMap,
+				"patchOps": opsList,
+			},
+		},
+	})
+}
+
+// validateTxPatchOp checks a single JSON patch operation.
+func validateTxPatchOp(op any) error {
+	opMap, ok := op.(map[string]any)
+	if !ok {
+		return fmt.Errorf("must be an object")
+	}
+	opName, _ := opMap["op"].(string)
+	if !slices.Contains(txPatchOps, opName) {
+		return fmt.Errorf("op must be one of %v, got %q", txPatchOps, opName)
+	}
+	if s, _ := opMap["path"].(string); s == "" {
+		return fmt.Errorf("path must be a non-empty string")
+	}
+	switch opName {
+	case "add", "replace", "test":
+		if _, found := opMap["value"]; !found {
+			return fmt.Errorf("value is required for %s", opName)
+		}
+	case "move", "copy":
+		if s, _ := opMap["from"].(string); s == "" {
+			return fmt.Errorf("from is required for %s", opName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateTxPatchOp(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      any
+		wantErr bool
+	}{
+		{
+			name: "add",
+			op:   map[string]any{"op": "add", "path": "/spec/description", "value": "uplink"},
+		},
+		{
+			name: "remove",
+			op:   map[string]any{"op": "remove", "path": "/spec/description"},
+		},
+		{
+			name: "replace with a null value",
+			op:   map[string]any{"op": "replace", "path": "/spec/description", "value": nil},
+		},
+		{
+			name: "move",
+			op:   map[string]any{"op": "move", "from": "/spec/description", "path": "/metadata/annotations/description"},
+		},
+		{
+			name:    "not an object",
+			op:      "add",
+			wantErr: true,
+		},
+		{
+			name:    "unknown op",
+			op:      map[string]any{"op": "merge", "path": "/spec", "value": map[string]any{}},
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			op:      map[string]any{"op": "remove"},
+			wantErr: true,
+		},
+		{
+			name:    "add without a value",
+			op:      map[string]any{"op": "add", "path": "/spec/description"},
+			wantErr: true,
+		},
+		{
+			name:    "copy without from",
+			op:      map[string]any{"op": "copy", "path": "/spec/description"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTxPatchOp(tt.op); (err != nil) != tt.wantErr {
+				t.Errorf("validateTxPatchOp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTxPatchFunction(t *testing.T) {
+	target := map[string]any{
+		"gvk":       map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "kind": "Interface"},
+		"name":      "leaf1-ethernet-1-1",
+		"namespace": "eda",
+	}
+	ops := []any{map[string]any{"op": "replace", "path": "/spec/enabled", "value": false}}
+
+	tests := []struct {
+		name    string
+		target  any
+		ops     any
+		want    any
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			target: target,
+			ops:    ops,
+			want: map[string]any{"type": map[string]any{"patch": map[string]any{
+				"patchOps": ops,
+				"target":   target,
+			}}},
+		},
+		{
+			name: "missing group",
+			target: map[string]any{
+				"gvk":  map[string]any{"version": "v1", "kind": "ConfigMap"},
+				"name": "settings",
+			},
+			ops:     ops,
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			target: map[string]any{
+				"gvk": map[string]any{"group": "", "version": "v1", "kind": "ConfigMap"},
+			},
+			ops:     ops,
+			wantErr: true,
+		},
+		{
+			name:    "no ops",
+			target:  target,
+			ops:     []any{},
+			wantErr: true,
+		},
+		{
+			name:    "bad op",
+			target:  target,
+			ops:     []any{map[string]any{"op": "replace", "path": "/spec/enabled"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runTxFunction(t, NewTxPatchFunction(), txDynamicArg(t, tt.target), txDynamicArg(t, tt.ops))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !crValuesEqual(got, tt.want) {
+				t.Errorf("Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
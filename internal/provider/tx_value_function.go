@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+var _ function.Function = (*txValueFunction)(nil)
+
+func NewTxCreateFunction() function.Function {
+	return &txValueFunction{op: "create", verb: "creates"}
+}
+
+func NewTxModifyFunction() function.Function {
+	return &txValueFunction{op: "modify", verb: "modifies"}
+}
+
+func NewTxReplaceFunction() function.Function {
+	return &txValueFunction{op: "replace", verb: "replaces"}
+}
+
+// txValueFunction wraps a CR into a transaction entry of one of the
+// operations that carry the full CR as value, create, modify or replace.
+type txValueFunction struct {
+	op   string
+	verb string
+}
+
+func (f *txValueFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tx_" + f.op
+}
+
+func (f *txValueFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             fmt.Sprintf("Builds a transaction entry that %s a CR", f.verb),
+		Description:         fmt.Sprintf("Wraps a CR into a %s entry for the crs of a transaction or a merge request. The CR must have apiVersion, kind and metadata.name set.", f.op),
+		MarkdownDescription: fmt.Sprintf("Wraps a CR into a `%s` entry for the `crs` of a transaction or a merge request. The CR must have `apiVersion`, `kind` and `metadata.name` set.", f.op),
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "cr",
+				Description:         "The CR, with apiVersion, kind, metadata and spec, as accepted by the API.",
+				MarkdownDescription: "The CR, with `apiVersion`, `kind`, `metadata` and `spec`, as accepted by the API.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *txValueFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cr types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cr))
+
+	if resp.Error != nil {
+		return
+	}
+
+	crVal, err := tfutils.DynamicToAny(ctx, cr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	crMap, ok := crVal.(map[string]any)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "cr must be an object")
+		return
+	}
+	if err := validateTxCr(crMap); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	txSetResult(ctx, resp, map[string]any{
+		"type": map[string]any{
+			f.op: map[string]any{"value": crMap},
+		},
+	})
+}
+
+// validateTxCr checks that a CR carries the fields that identify it.
+func validateTxCr(cr map[string]any) error {
+	for _, key := range []string{"apiVersion", "kind"} {
+		if s, _ := cr[key].(string); s == "" {
+			return fmt.Errorf("cr.%s must be a non-empty string", key)
+		}
+	}
+	metadata, ok := cr["metadata"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("cr.metadata must be an object")
+	}
+	if s, _ := metadata["name"].(string); s == "" {
+		return fmt.Errorf("cr.metadata.name must be a non-empty string")
+	}
+	return nil
+}
+
+// validateTxGvk checks that a gvk object carries a group, a version and a kind. The group
+// is empty for CRs in the core group, but must be given all the same.
+func validateTxGvk(gvk map[string]any, argName string) error {
+	if _, ok := gvk["group"].(string); !ok {
+		return fmt.Errorf("%s.group must be a string, \"\" for CRs in the core group", argName)
+	}
+	for _, key := range []string{"version", "kind"} {
+		if s, _ := gvk[key].(string); s == "" {
+			return fmt.Errorf("%s.%s must be a non-empty string", argName, key)
+		}
+	}
+	return nil
+}
+
+// txSetResult sets the transaction entry as the dynamic result of a function.
+func txSetResult(ctx context.Context, resp *function.RunResponse, entry map[string]any) {
+	result, err := tfutils.AnyToDynamic(entry)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
+)
+
+func TestValidateTxCr(t *testing.T) {
+	tests := []struct {
+		name    string
+		cr      map[string]any
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cr: map[string]any{
+				"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+				"kind":       "Interface",
+				"metadata":   map[string]any{"name": "leaf1-ethernet-1-1", "namespace": "eda"},
+			},
+		},
+		{
+			name: "core group",
+			cr: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"name": "settings"},
+			},
+		},
+		{
+			name: "missing apiVersion",
+			cr: map[string]any{
+				"kind":     "Interface",
+				"metadata": map[string]any{"name": "leaf1-ethernet-1-1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty kind",
+			cr: map[string]any{
+				"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+				"kind":       "",
+				"metadata":   map[string]any{"name": "leaf1-ethernet-1-1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing metadata",
+			cr: map[string]any{
+				"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+				"kind":       "Interface",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing metadata.name",
+			cr: map[string]any{
+				"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+				"kind":       "Interface",
+				"metadata":   map[string]any{"namespace": "eda"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTxCr(tt.cr); (err != nil) != tt.wantErr {
+				t.Errorf("validateTxCr() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTxGvk(t *testing.T) {
+	tests := []struct {
+		name    string
+		gvk     map[string]any
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			gvk:  map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "kind": "Interface"},
+		},
+		{
+			name: "core group",
+			gvk:  map[string]any{"group": "", "version": "v1", "kind": "ConfigMap"},
+		},
+		{
+			name:    "missing group",
+			gvk:     map[string]any{"version": "v1", "kind": "ConfigMap"},
+			wantErr: true,
+		},
+		{
+			name:    "missing version",
+			gvk:     map[string]any{"group": "interfaces.eda.nokia.com", "kind": "Interface"},
+			wantErr: true,
+		},
+		{
+			name:    "empty kind",
+			gvk:     map[string]any{"group": "interfaces.eda.nokia.com", "version": "v1alpha1", "kind": ""},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTxGvk(tt.gvk, "gvk"); (err != nil) != tt.wantErr {
+				t.Errorf("validateTxGvk() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTxValueFunction(t *testing.T) {
+	cr := map[string]any{
+		"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+		"kind":       "Interface",
+		"metadata":   map[string]any{"name": "leaf1-ethernet-1-1", "namespace": "eda"},
+		"spec":       map[string]any{"enabled": true, "mtu": 9000},
+	}
+
+	tests := []struct {
+		name    string
+		f       function.Function
+		cr      any
+		want    any
+		wantErr bool
+	}{
+		{
+			name: "create",
+			f:    NewTxCreateFunction(),
+			cr:   cr,
+			want: map[string]any{"type": map[string]any{"create": map[string]any{"value": cr}}},
+		},
+		{
+			name: "modify",
+			f:    NewTxModifyFunction(),
+			cr:   cr,
+			want: map[string]any{"type": map[string]any{"modify": map[string]any{"value": cr}}},
+		},
+		{
+			name: "replace",
+			f:    NewTxReplaceFunction(),
+			cr:   cr,
+			want: map[string]any{"type": map[string]any{"replace": map[string]any{"value": cr}}},
+		},
+		{
+			name:    "not an object",
+			f:       NewTxCreateFunction(),
+			cr:      []any{cr},
+			wantErr: true,
+		},
+		{
+			name: "invalid CR",
+			f:    NewTxReplaceFunction(),
+			cr: map[string]any{
+				"apiVersion": "interfaces.eda.nokia.com/v1alpha1",
+				"metadata":   map[string]any{"name": "leaf1-ethernet-1-1"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runTxFunction(t, tt.f, txDynamicArg(t, tt.cr))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !crValuesEqual(got, tt.want) {
+				t.Errorf("Run() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// txDynamicArg returns v as a dynamic function argument.
+func txDynamicArg(t *testing.T, v any) attr.Value {
+	t.Helper()
+	d, err := tfutils.AnyToDynamic(v)
+	if err != nil {
+		t.Fatalf("AnyToDynamic() error = %v", err)
+	}
+	return d
+}
+
+// runTxFunction runs f with args, and returns the entry it built.
+func runTxFunction(t *testing.T, f function.Function, args ...attr.Value) (any, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(types.DynamicNull())}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	result, ok := resp.Result.Value().(types.Dynamic)
+	if !ok {
+		t.Fatalf("Run() result = %T, want a dynamic value", resp.Result.Value())
+	}
+	entry, err := tfutils.DynamicToAny(ctx, result)
+	if err != nil {
+		t.Fatalf("DynamicToAny() error = %v", err)
+	}
+	return entry, nil
+}
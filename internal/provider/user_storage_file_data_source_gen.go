@@ -1,15 +1,17 @@
+// Code generated by wrappers from specs/config.yml. DO NOT EDIT.
+
 package provider
 
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_user_storage_file"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
@@ -25,7 +27,13 @@ func NewUserStorageFileDataSource() datasource.DataSource {
 }
 
 type userStorageFileDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
+}
+
+// userStorageFileDataSourceModel is the model of the data source with the extra attribute.
+type userStorageFileDataSourceModel struct {
+	datasource_user_storage_file.UserStorageFileModel
+	tfutils.ExtraModel
 }
 
 func (d *userStorageFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +41,11 @@ func (d *userStorageFileDataSource) Metadata(ctx context.Context, req datasource
 }
 
 func (d *userStorageFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_user_storage_file.UserStorageFileDataSourceSchema(ctx)
+	resp.Schema = tfutils.AddExtraAttribute(datasource_user_storage_file.UserStorageFileDataSourceSchema(ctx))
 }
 
 func (d *userStorageFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_user_storage_file.UserStorageFileModel
+	var data userStorageFileDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +55,7 @@ func (d *userStorageFileDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.UserStorageFileModel, oasQueryParams(http.MethodGet, read_ds_userStorageFile))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -76,7 +84,12 @@ func (d *userStorageFileDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, result, &data)
+	decoder := d.oasDecoder(http.MethodGet, read_ds_userStorageFile)
+	err = decoder.AnyMapToModel(ctx, result, &data.UserStorageFileModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
+		return
+	}
+	data.Extra, err = decoder.ExtraValue(result, &data.UserStorageFileModel)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
 		return
@@ -94,14 +107,14 @@ func (r *userStorageFileDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
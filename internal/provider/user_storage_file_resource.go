@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_user_storage_file"
+)
+
+const rs_userStorageFile = "/core/user-storage/v2/file"
+
+var (
+	_ resource.Resource                = (*userStorageFileResource)(nil)
+	_ resource.ResourceWithConfigure   = (*userStorageFileResource)(nil)
+	_ resource.ResourceWithImportState = (*userStorageFileResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*userStorageFileResource)(nil)
+)
+
+func NewUserStorageFileResource() resource.Resource {
+	return &userStorageFileResource{
+		typeName: "_user_storage_file",
+		filePath: rs_userStorageFile,
+	}
+}
+
+// userStorageFileResource manages a single user-storage file. The same implementation
+// serves both the per-user and the shared region, which only differ by API path.
+type userStorageFileResource struct {
+	providerData
+	typeName string
+	filePath string
+}
+
+func (r *userStorageFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *userStorageFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_user_storage_file.UserStorageFileResourceSchema(ctx)
+}
+
+// ModifyPlan computes the hash of the configured content, so that a change to a local
+// source file, or to the file in EDA, shows up as a difference in the plan.
+func (r *userStorageFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resource_user_storage_file.UserStorageFileModel
+	var prior *resource_user_storage_file.UserStorageFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		prior = &resource_user_storage_file.UserStorageFileModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, prior)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrPath, err := planUserStorageFile(&plan, prior)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attrPath, "Invalid file content", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *userStorageFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_user_storage_file.UserStorageFileModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, &data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", err.Error())
+		return
+	}
+
+	err = r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Save created data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *userStorageFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_user_storage_file.UserStorageFileModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.read(ctx, &data)
+	if apiclient.IsNotFound(err) {
+		// The file was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userStorageFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_user_storage_file.UserStorageFileModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.write(ctx, &data, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating resource", err.Error())
+		return
+	}
+
+	err = r.read(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userStorageFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_user_storage_file.UserStorageFileModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	tflog.Info(ctx, "Delete()::API request", map[string]any{
+		"path": r.filePath,
+		"file": data.Path.ValueString(),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.DeleteByQuery(ctx, r.filePath, nil, url.Values{
+		"path": {data.Path.ValueString()},
+	}, &result)
+
+	tflog.Info(ctx, "Delete()::API returned", map[string]any{
+		"path":      r.filePath,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil && !apiclient.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting resource", err.Error())
+		return
+	}
+}
+
+// write uploads the configured content, always base64 encoded so that binary content survives.
+func (r *userStorageFileResource) write(ctx context.Context, data *resource_user_storage_file.UserStorageFileModel, update bool) error {
+	content, _, err := userStorageFileContent(data)
+	if err != nil {
+		return err
+	}
+
+	queryParams := url.Values{
+		"path":           {data.Path.ValueString()},
+		"base64-encoded": {"true"},
+	}
+	reqBody := map[string]any{
+		"file-content": base64.StdEncoding.EncodeToString(content),
+	}
+
+	tflog.Info(ctx, "write()::API request", map[string]any{
+		"path":   r.filePath,
+		"query":  queryParams,
+		"update": update,
+		"size":   len(content),
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	if update {
+		err = r.client.UpdateByQuery(ctx, r.filePath, nil, queryParams, reqBody, &result)
+	} else {
+		err = r.client.CreateByQuery(ctx, r.filePath, nil, queryParams, reqBody, &result)
+	}
+
+	tflog.Info(ctx, "write()::API returned", map[string]any{
+		"path":      r.filePath,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	return err
+}
+
+// read fetches the file and refreshes the computed attributes of the model.
+// The configured content is kept as is, drift is tracked through content_hash.
+func (r *userStorageFileResource) read(ctx context.Context, data *resource_user_storage_file.UserStorageFileModel) error {
+	queryParams := url.Values{
+		"path":          {data.Path.ValueString()},
+		"base64-encode": {"true"},
+	}
+
+	tflog.Info(ctx, "read()::API request", map[string]any{
+		"path":  r.filePath,
+		"query": queryParams,
+	})
+
+	t0 := time.Now()
+	result := map[string]any{}
+
+	err := r.client.GetByQuery(ctx, r.filePath, nil, queryParams, &result)
+
+	tflog.Info(ctx, "read()::API returned", map[string]any{
+		"path":      r.filePath,
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	encoded, _ := result["file-content"].(string)
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to decode file content: %w", err)
+	}
+	fileName, _ := result["file-name"].(string)
+	modTime, _ := result["modification-time"].(string)
+
+	data.ContentHash = types.StringValue(userStorageFileHash(content))
+	data.FileName = types.StringValue(fileName)
+	data.ModificationTime = types.StringValue(modTime)
+	return nil
+}
+
+// planUserStorageFile sets the content_hash and modification_time planned for the file, with
+// prior nil on create. The modification time only changes when the content is uploaded again,
+// and both are unknown when the content is. On error, the path of the content attribute is returned.
+func planUserStorageFile(plan, prior *resource_user_storage_file.UserStorageFileModel) (path.Path, error) {
+	if plan.Content.IsUnknown() || plan.ContentBase64.IsUnknown() || plan.Source.IsUnknown() {
+		plan.ContentHash = types.StringUnknown()
+		plan.ModificationTime = types.StringUnknown()
+		return path.Empty(), nil
+	}
+
+	content, attrPath, err := userStorageFileContent(plan)
+	if err != nil {
+		return attrPath, err
+	}
+	plan.ContentHash = types.StringValue(userStorageFileHash(content))
+
+	if prior != nil && plan.ContentHash.Equal(prior.ContentHash) {
+		plan.ModificationTime = prior.ModificationTime
+	} else {
+		plan.ModificationTime = types.StringUnknown()
+	}
+	return attrPath, nil
+}
+
+// userStorageFileContent returns the bytes to upload from whichever of content,
+// content_base64 or source is set, along with the path of that attribute.
+func userStorageFileContent(data *resource_user_storage_file.UserStorageFileModel) ([]byte, path.Path, error) {
+	switch {
+	case !data.ContentBase64.IsNull():
+		attrPath := path.Root("content_base64")
+		content, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+		if err != nil {
+			return nil, attrPath, fmt.Errorf("content_base64 is not valid base64: %w", err)
+		}
+		return content, attrPath, nil
+	case !data.Source.IsNull():
+		attrPath := path.Root("source")
+		content, err := os.ReadFile(data.Source.ValueString())
+		if err != nil {
+			return nil, attrPath, fmt.Errorf("failed to read source file: %w", err)
+		}
+		return content, attrPath, nil
+	default:
+		return []byte(data.Content.ValueString()), path.Root("content"), nil
+	}
+}
+
+// userStorageFileHash returns the content_hash of the content, its hex encoded SHA-256.
+func userStorageFileHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userStorageFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *userStorageFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected id = <path> format, got: id = %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), req.ID)...)
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/resource_user_storage_file"
+)
+
+const (
+	// userStorageTestHash is the SHA-256 of "hello world\n".
+	userStorageTestHash = "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"
+	// userStorageTestBase64 is "hello world\n" base64 encoded.
+	userStorageTestBase64 = "aGVsbG8gd29ybGQK"
+)
+
+func TestUserStorageFileContent(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "hello.txt")
+	if err := os.WriteFile(source, []byte("hello world\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		data     resource_user_storage_file.UserStorageFileModel
+		want     string
+		wantPath path.Path
+		wantErr  bool
+	}{
+		{
+			name: "content",
+			data: resource_user_storage_file.UserStorageFileModel{
+				Content:       types.StringValue("hello world\n"),
+				ContentBase64: types.StringNull(),
+				Source:        types.StringNull(),
+			},
+			want:     "hello world\n",
+			wantPath: path.Root("content"),
+		},
+		{
+			name: "content_base64",
+			data: resource_user_storage_file.UserStorageFileModel{
+				Content:       types.StringNull(),
+				ContentBase64: types.StringValue(userStorageTestBase64),
+				Source:        types.StringNull(),
+			},
+			want:     "hello world\n",
+			wantPath: path.Root("content_base64"),
+		},
+		{
+			name: "source",
+			data: resource_user_storage_file.UserStorageFileModel{
+				Content:       types.StringNull(),
+				ContentBase64: types.StringNull(),
+				Source:        types.StringValue(source),
+			},
+			want:     "hello world\n",
+			wantPath: path.Root("source"),
+		},
+		{
+			name: "empty content",
+			data: resource_user_storage_file.UserStorageFileModel{
+				Content:       types.StringValue(""),
+				ContentBase64: types.StringNull(),
+				Source:        types.StringNull(),
+			},
+			want:     "",
+			wantPath: path.Root("content"),
+		},
+		{
+			name: "invalid content_base64",
+			data: resource_user_storage_file.UserStorageFileModel{
+				Content:       types.StringNull(),
+				ContentBase64: types.StringValue("not base64!"),
+				Source:        types.StringNull(),
+			},
+			wantPath: path.Root("content_base64"),
+			wantErr:  true,
+		},
+		{
+			name: "missing source",
+			data: resource_user_storage_file.UserStorageFileModel{
+				Content:       types.StringNull(),
+				ContentBase64: types.StringNull(),
+				Source:        types.StringValue(filepath.Join(t.TempDir(), "missing.txt")),
+			},
+			wantPath: path.Root("source"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, attrPath, err := userStorageFileContent(&tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("userStorageFileContent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !attrPath.Equal(tt.wantPath) {
+				t.Errorf("userStorageFileContent() path = %s, want %s", attrPath, tt.wantPath)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("userStorageFileContent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserStorageFileHash(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{content: "", want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{content: "hello world\n", want: userStorageTestHash},
+	}
+
+	for _, tt := range tests {
+		if got := userStorageFileHash([]byte(tt.content)); got != tt.want {
+			t.Errorf("userStorageFileHash(%q) = %s, want %s", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestPlanUserStorageFile(t *testing.T) {
+	prior := &resource_user_storage_file.UserStorageFileModel{
+		Content:          types.StringValue("hello world\n"),
+		ContentBase64:    types.StringNull(),
+		ContentHash:      types.StringValue(userStorageTestHash),
+		ModificationTime: types.StringValue("2026-10-19T08:00:00Z"),
+		Source:           types.StringNull(),
+	}
+
+	tests := []struct {
+		name          string
+		content       types.String
+		contentBase64 types.String
+		prior         *resource_user_storage_file.UserStorageFileModel
+		wantHash      types.String
+		wantModTime   types.String
+	}{
+		{
+			name:          "create",
+			content:       types.StringValue("hello world\n"),
+			contentBase64: types.StringNull(),
+			wantHash:      types.StringValue(userStorageTestHash),
+			wantModTime:   types.StringUnknown(),
+		},
+		{
+			name:          "same content",
+			content:       types.StringValue("hello world\n"),
+			contentBase64: types.StringNull(),
+			prior:         prior,
+			wantHash:      types.StringValue(userStorageTestHash),
+			wantModTime:   types.StringValue("2026-10-19T08:00:00Z"),
+		},
+		{
+			name:          "same content in base64",
+			content:       types.StringNull(),
+			contentBase64: types.StringValue(userStorageTestBase64),
+			prior:         prior,
+			wantHash:      types.StringValue(userStorageTestHash),
+			wantModTime:   types.StringValue("2026-10-19T08:00:00Z"),
+		},
+		{
+			name:          "changed content",
+			content:       types.StringValue("goodbye\n"),
+			contentBase64: types.StringNull(),
+			prior:         prior,
+			wantHash:      types.StringValue(userStorageFileHash([]byte("goodbye\n"))),
+			wantModTime:   types.StringUnknown(),
+		},
+		{
+			name:          "unknown content",
+			content:       types.StringUnknown(),
+			contentBase64: types.StringNull(),
+			prior:         prior,
+			wantHash:      types.StringUnknown(),
+			wantModTime:   types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &resource_user_storage_file.UserStorageFileModel{
+				Content:          tt.content,
+				ContentBase64:    tt.contentBase64,
+				ContentHash:      types.StringUnknown(),
+				ModificationTime: types.StringUnknown(),
+				Source:           types.StringNull(),
+			}
+			if _, err := planUserStorageFile(plan, tt.prior); err != nil {
+				t.Fatalf("planUserStorageFile() error = %v", err)
+			}
+			if !plan.ContentHash.Equal(tt.wantHash) {
+				t.Errorf("planUserStorageFile() content_hash = %s, want %s", plan.ContentHash, tt.wantHash)
+			}
+			if !plan.ModificationTime.Equal(tt.wantModTime) {
+				t.Errorf("planUserStorageFile() modification_time = %s, want %s", plan.ModificationTime, tt.wantModTime)
+			}
+		})
+	}
+}
+
+func TestUserStorageFileResources(t *testing.T) {
+	tests := []struct {
+		resource     resource.Resource
+		wantTypeName string
+		wantPath     string
+	}{
+		{resource: NewUserStorageFileResource(), wantTypeName: "core-v1_user_storage_file", wantPath: rs_userStorageFile},
+		{resource: NewUserStorageSharedFileResource(), wantTypeName: "core-v1_user_storage_shared_file", wantPath: rs_userStorageSharedFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantTypeName, func(t *testing.T) {
+			resp := &resource.MetadataResponse{}
+			tt.resource.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "core-v1"}, resp)
+			if resp.TypeName != tt.wantTypeName {
+				t.Errorf("Metadata() type name = %s, want %s", resp.TypeName, tt.wantTypeName)
+			}
+			// Both regions share the implementation, only the API path differs
+			r, ok := tt.resource.(*userStorageFileResource)
+			if !ok {
+				t.Fatalf("resource = %T, want *userStorageFileResource", tt.resource)
+			}
+			if r.filePath != tt.wantPath {
+				t.Errorf("resource path = %s, want %s", r.filePath, tt.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const rs_userStorageSharedFile = "/core/user-storage/v2/shared/file"
+
+func NewUserStorageSharedFileResource() resource.Resource {
+	return &userStorageFileResource{
+		typeName: "_user_storage_shared_file",
+		filePath: rs_userStorageSharedFile,
+	}
+}
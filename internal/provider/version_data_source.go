@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_version"
+)
+
+const (
+	read_ds_version = "/core/about/version"
+
+	// versionEdaComponent is the component that carries the overall EDA version
+	versionEdaComponent = "eda"
+)
+
+// versionInfo is the version information of a single component.
+type versionInfo struct {
+	BuiltDate string `json:"builtDate"`
+	Version   string `json:"version"`
+}
+
+var (
+	_ datasource.DataSource              = (*versionDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*versionDataSource)(nil)
+)
+
+func NewVersionDataSource() datasource.DataSource {
+	return &versionDataSource{}
+}
+
+type versionDataSource struct {
+	providerData
+}
+
+func (d *versionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (d *versionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_version.VersionDataSourceSchema(ctx)
+}
+
+func (d *versionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_version.VersionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Read()::API request", map[string]any{
+		"path": read_ds_version,
+	})
+
+	t0 := time.Now()
+	result := map[string]versionInfo{}
+	err := d.client.Get(ctx, read_ds_version, nil, &result)
+
+	tflog.Info(ctx, "Read()::API returned", map[string]any{
+		"path":      read_ds_version,
+		"result":    spew.Sdump(result),
+		"timeTaken": time.Since(t0).String(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resource", err.Error())
+		return
+	}
+
+	// Convert API response to Terraform model, the "eda" component is the overall product
+	components := map[string]datasource_version.ComponentModel{}
+	for name, info := range result {
+		components[name] = datasource_version.ComponentModel{
+			BuiltDate: types.StringValue(info.BuiltDate),
+			Version:   types.StringValue(info.Version),
+		}
+	}
+	data.BuiltDate = types.StringValue(result[versionEdaComponent].BuiltDate)
+	data.Version = types.StringValue(result[versionEdaComponent].Version)
+
+	componentsVal, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: datasource_version.ComponentAttrTypes}, components)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Components = componentsVal
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *versionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = *data
+}
@@ -3,21 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/datasource_workflow_status_summary"
-	"github.com/nokia/eda/apps/terraform-provider-core/internal/eda/apiclient"
 	"github.com/nokia/eda/apps/terraform-provider-core/internal/tfutils"
 )
 
 const read_ds_workflowStatusSummary = "/core/workflows/v1"
 
 var (
-	_ datasource.DataSource              = (*workflowStatusSummaryDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*workflowStatusSummaryDataSource)(nil)
+	_ datasource.DataSource                   = (*workflowStatusSummaryDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*workflowStatusSummaryDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*workflowStatusSummaryDataSource)(nil)
 )
 
 func NewWorkflowStatusSummaryDataSource() datasource.DataSource {
@@ -25,7 +27,7 @@ func NewWorkflowStatusSummaryDataSource() datasource.DataSource {
 }
 
 type workflowStatusSummaryDataSource struct {
-	client *apiclient.EdaApiClient
+	providerData
 }
 
 func (d *workflowStatusSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,11 +35,11 @@ func (d *workflowStatusSummaryDataSource) Metadata(ctx context.Context, req data
 }
 
 func (d *workflowStatusSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = datasource_workflow_status_summary.WorkflowStatusSummaryDataSourceSchema(ctx)
+	resp.Schema = datasource_workflow_status_summary.WorkflowStatusSummaryCustomDataSourceSchema(ctx)
 }
 
 func (d *workflowStatusSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data datasource_workflow_status_summary.WorkflowStatusSummaryModel
+	var data datasource_workflow_status_summary.WorkflowStatusSummaryCustomModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -47,7 +49,7 @@ func (d *workflowStatusSummaryDataSource) Read(ctx context.Context, req datasour
 	}
 
 	// Extract query params from Terraform model
-	queryParams, err := tfutils.ModelToStringMap(ctx, &data)
+	queryParams, err := tfutils.ModelToQueryValues(ctx, &data.WorkflowStatusSummaryModel, oasQueryParams(http.MethodGet, read_ds_workflowStatusSummary))
 	if err != nil {
 		resp.Diagnostics.AddError("Error extracting query params", err.Error())
 		return
@@ -75,14 +77,20 @@ func (d *workflowStatusSummaryDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
+	// Apply the client-side list options
+	items, err := data.Apply(ctx, oasConverter(http.MethodGet, read_ds_workflowStatusSummary, "workflowStatusSummary"), "workflowStatusSummary", result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error applying list options", err.Error())
+		return
+	}
+
 	newResult := map[string]any{
-		"workflowStatusSummary": result,
+		"workflowStatusSummary": items,
 	}
 
 	// Convert API response to Terraform model
-	err = tfutils.AnyMapToModel(ctx, newResult, &data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to build response from API result", err.Error())
+	err = d.oasDecoder(http.MethodGet, read_ds_workflowStatusSummary, "workflowStatusSummary").AnyMapToModel(ctx, newResult, &data.WorkflowStatusSummaryModel)
+	if tfutils.AddConversionError(&resp.Diagnostics, "Failed to build response from API result", err) {
 		return
 	}
 
@@ -90,6 +98,12 @@ func (d *workflowStatusSummaryDataSource) Read(ctx context.Context, req datasour
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ValidateConfig checks the syntax of the query inputs during plan.
+func (d *workflowStatusSummaryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateQueryAttribute(ctx, d.client, eqlWhereCheck, req.Config, path.Root("filter"), &resp.Diagnostics)
+	validateLabelSelectorAttribute(ctx, req.Config, path.Root("label_selector"), &resp.Diagnostics)
+}
+
 // Configure adds the provider configured client to the data source.
 func (r *workflowStatusSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -98,14 +112,14 @@ func (r *workflowStatusSummaryDataSource) Configure(_ context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.EdaApiClient)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *api.EdaApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.providerData = *data
 }
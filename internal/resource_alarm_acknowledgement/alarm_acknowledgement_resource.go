@@ -0,0 +1,126 @@
+package resource_alarm_acknowledgement
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AlarmAttrTypes are the attribute types of an element of the alarms list.
+var AlarmAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"namespace": types.StringType,
+}
+
+type AlarmAcknowledgementModel struct {
+	Action          types.String `tfsdk:"action"`
+	AlarmName       types.String `tfsdk:"alarm_name"`
+	Alarms          types.List   `tfsdk:"alarms"`
+	DeleteOnDestroy types.Bool   `tfsdk:"delete_on_destroy"`
+	Duration        types.Int64  `tfsdk:"duration"`
+	Filter          types.String `tfsdk:"filter"`
+	Id              types.String `tfsdk:"id"`
+	Namespace       types.String `tfsdk:"namespace"`
+}
+
+func AlarmAcknowledgementResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The action to take on the alarms, either acknowledge or suppress. The action is reverted on destroy.",
+				MarkdownDescription: "The action to take on the alarms, either `acknowledge` or `suppress`. The action is reverted on destroy.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"acknowledge",
+						"suppress",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alarm_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of a single alarm to act on.",
+				MarkdownDescription: "The name of a single alarm to act on.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("alarm_name"),
+						path.MatchRoot("filter"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alarms": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of an alarm",
+							MarkdownDescription: "The name of an alarm",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The namespace of an alarm",
+							MarkdownDescription: "The namespace of an alarm",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The alarms the action is currently in effect on.",
+				MarkdownDescription: "The alarms the action is currently in effect on.",
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, the alarms are also deleted on destroy. Alarms that are not cleared are not deleted.",
+				MarkdownDescription: "If true, the alarms are also deleted on destroy. Alarms that are not cleared are not deleted.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"duration": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The duration (in milliseconds) for the acknowledge or suppress action. If not set, the action does not expire.",
+				MarkdownDescription: "The duration (in milliseconds) for the acknowledge or suppress action. If not set, the action does not expire.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"filter": schema.StringAttribute{
+				Optional:            true,
+				Description:         "An EDA-query-language \"where\" expression selecting the alarms to act on. The filter is resolved again on each plan, and the alarms matching it on which the action is not in effect, such as new alarms or alarms unacknowledged outside of Terraform, are planned for the action.",
+				MarkdownDescription: "An EDA-query-language \"where\" expression selecting the alarms to act on. The filter is resolved again on each plan, and the alarms matching it on which the action is not in effect, such as new alarms or alarms unacknowledged outside of Terraform, are planned for the action.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the acknowledgement, built from the namespace and the alarm name or filter.",
+				MarkdownDescription: "Identifier of the acknowledgement, built from the namespace and the alarm name or filter.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The namespace of the alarms. If not set, non-namespaced alarms are acted on, or for a filter, alarms across all namespaces.",
+				MarkdownDescription: "The namespace of the alarms. If not set, non-namespaced alarms are acted on, or for a filter, alarms across all namespaces.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package resource_auth_provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CUSTOM MODEL
+// AuthProviderCustomResourceSchema extends the generated schema with attributes
+// that only control provider behaviour and are never sent to the API.
+func AuthProviderCustomResourceSchema(ctx context.Context) schema.Schema {
+	s := AuthProviderResourceSchema(ctx)
+	s.Attributes["skip_connection_test"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "If true, the connection and bind test against the LDAP server is not run before the provider is created or updated.",
+		MarkdownDescription: "If true, the connection and bind test against the LDAP server is not run before the provider is created or updated.",
+		Default:             booldefault.StaticBool(false),
+	}
+	return s
+}
+
+type AuthProviderCustomModel struct {
+	Auth               AuthValue         `tfsdk:"auth"`
+	Enabled            types.Bool        `tfsdk:"enabled"`
+	GroupSupport       GroupSupportValue `tfsdk:"group_support"`
+	IdAttribute        types.String      `tfsdk:"id_attribute"`
+	Import             types.Bool        `tfsdk:"import"`
+	Name               types.String      `tfsdk:"name"`
+	Pagination         types.Bool        `tfsdk:"pagination"`
+	PeriodicSync       types.Bool        `tfsdk:"periodic_sync"`
+	PeriodicSyncSecs   types.Int64       `tfsdk:"periodic_sync_secs"`
+	RdnLdapAttribute   types.String      `tfsdk:"rdn_ldap_attribute"`
+	ReadOnly           types.Bool        `tfsdk:"read_only"`
+	Scope              types.String      `tfsdk:"scope"`
+	SkipConnectionTest types.Bool        `tfsdk:"skip_connection_test" tfutils:"write_only"`
+	Timeout            types.Int64       `tfsdk:"timeout"`
+	Tls                types.Bool        `tfsdk:"tls"`
+	Type               types.String      `tfsdk:"type"`
+	Url                types.String      `tfsdk:"url"`
+	UserDn             types.String      `tfsdk:"user_dn"`
+	UserObjectClasses  types.String      `tfsdk:"user_object_classes"`
+	UserSearchFilter   types.String      `tfsdk:"user_search_filter"`
+	UsernameAttribute  types.String      `tfsdk:"username_attribute"`
+	Uuid               types.String      `tfsdk:"uuid"`
+	Vendor             types.String      `tfsdk:"vendor"`
+}
@@ -0,0 +1,111 @@
+package resource_cr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MetadataAttrTypes are the attribute types of the metadata object.
+var MetadataAttrTypes = map[string]attr.Type{
+	"annotations": types.MapType{ElemType: types.StringType},
+	"labels":      types.MapType{ElemType: types.StringType},
+	"name":        types.StringType,
+	"namespace":   types.StringType,
+}
+
+type CrModel struct {
+	ApiVersion    types.String  `tfsdk:"api_version"`
+	Id            types.String  `tfsdk:"id"`
+	Kind          types.String  `tfsdk:"kind"`
+	Metadata      types.Object  `tfsdk:"metadata"`
+	Spec          types.Dynamic `tfsdk:"spec"`
+	TransactionId types.Int64   `tfsdk:"transaction_id"`
+}
+
+type MetadataModel struct {
+	Annotations types.Map    `tfsdk:"annotations"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+}
+
+func CrResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_version": schema.StringAttribute{
+				Required:            true,
+				Description:         "The API group and version of the CR, e.g. \"interfaces.eda.nokia.com/v1alpha1\".",
+				MarkdownDescription: "The API group and version of the CR, e.g. `\"interfaces.eda.nokia.com/v1alpha1\"`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the CR, in the group/version/kind/namespace/name format also used for import.",
+				MarkdownDescription: "The identifier of the CR, in the `group/version/kind/namespace/name` format also used for import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				Description:         "The kind of the CR, e.g. \"Interface\".",
+				MarkdownDescription: "The kind of the CR, e.g. `\"Interface\"`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"annotations": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Description:         "Annotations of the CR",
+						MarkdownDescription: "Annotations of the CR",
+					},
+					"labels": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Description:         "Labels of the CR",
+						MarkdownDescription: "Labels of the CR",
+					},
+					"name": schema.StringAttribute{
+						Required:            true,
+						Description:         "Name of the CR",
+						MarkdownDescription: "Name of the CR",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"namespace": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Namespace of the CR, omit for cluster scoped CRs",
+						MarkdownDescription: "Namespace of the CR, omit for cluster scoped CRs",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+				Required:            true,
+				Description:         "Metadata of the CR",
+				MarkdownDescription: "Metadata of the CR",
+			},
+			"spec": schema.DynamicAttribute{
+				Optional:            true,
+				Description:         "The spec of the CR, in the same form as the API, with camelCase field names.",
+				MarkdownDescription: "The spec of the CR, in the same form as the API, with camelCase field names.",
+			},
+			"transaction_id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The identifier of the last transaction that created or replaced the CR.",
+				MarkdownDescription: "The identifier of the last transaction that created or replaced the CR.",
+			},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package resource_merge_request
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MergeRequestModel struct {
+	AutoMerge     types.Bool    `tfsdk:"auto_merge"`
+	Closed        types.Bool    `tfsdk:"closed"`
+	Crs           types.Dynamic `tfsdk:"crs"`
+	Description   types.String  `tfsdk:"description"`
+	Id            types.Int64   `tfsdk:"id"`
+	TransactionId types.Int64   `tfsdk:"transaction_id"`
+}
+
+func MergeRequestResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_merge": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "If true the merge request is merged as soon as it is opened. Leave this unset when the merge request must be approved inside EDA before it is merged.",
+				MarkdownDescription: "If true the merge request is merged as soon as it is opened. Leave this unset when the merge request must be approved inside EDA before it is merged.",
+			},
+			"closed": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "If true the merge request is closed, setting it back to false reopens it.",
+				MarkdownDescription: "If true the merge request is closed, setting it back to false reopens it.",
+			},
+			"crs": schema.DynamicAttribute{
+				Required:            true,
+				Description:         "List of CRs to include in the merge request. The CRs are sent as written, their keys are not converted to camelCase.",
+				MarkdownDescription: "List of CRs to include in the merge request. The CRs are sent as written, their keys are not converted to camelCase.",
+			},
+			"description": schema.StringAttribute{
+				Required:            true,
+				Description:         "Description of the merge request",
+				MarkdownDescription: "Description of the merge request",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The merge request identifier, assigned by the system.",
+				MarkdownDescription: "The merge request identifier, assigned by the system.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"transaction_id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The identifier of the transaction that merged the merge request, if it was merged by the provider.",
+				MarkdownDescription: "The identifier of the transaction that merged the merge request, if it was merged by the provider.",
+			},
+		},
+	}
+}
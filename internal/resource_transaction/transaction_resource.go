@@ -81,8 +81,8 @@ func TransactionResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"crs": schema.DynamicAttribute{
 				Required:            true,
-				Description:         "List of CRs to include in the transaction",
-				MarkdownDescription: "List of CRs to include in the transaction",
+				Description:         "List of CRs to include in the transaction. The CRs are sent as written, their keys are not converted to camelCase.",
+				MarkdownDescription: "List of CRs to include in the transaction. The CRs are sent as written, their keys are not converted to camelCase.",
 			},
 			"description": schema.StringAttribute{
 				Required:            true,
@@ -0,0 +1,78 @@
+package resource_user_storage_file
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserStorageFileModel struct {
+	Content          types.String `tfsdk:"content"`
+	ContentBase64    types.String `tfsdk:"content_base64"`
+	ContentHash      types.String `tfsdk:"content_hash"`
+	FileName         types.String `tfsdk:"file_name"`
+	ModificationTime types.String `tfsdk:"modification_time"`
+	Path             types.String `tfsdk:"path"`
+	Source           types.String `tfsdk:"source"`
+}
+
+func UserStorageFileResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Content of the file, as plain text.",
+				MarkdownDescription: "Content of the file, as plain text.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("content"),
+						path.MatchRoot("content_base64"),
+						path.MatchRoot("source"),
+					),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Content of the file, base64 encoded. Use this for binary content.",
+				MarkdownDescription: "Content of the file, base64 encoded. Use this for binary content.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:            true,
+				Description:         "SHA-256 hash of the file content, used to detect changes made outside of Terraform.",
+				MarkdownDescription: "SHA-256 hash of the file content, used to detect changes made outside of Terraform.",
+			},
+			"file_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "name of the file",
+				MarkdownDescription: "name of the file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modification_time": schema.StringAttribute{
+				Computed:            true,
+				Description:         "UTC modification time of the file, as an RFC 3339 date/time.",
+				MarkdownDescription: "UTC modification time of the file, as an RFC 3339 date/time.",
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				Description:         "path for the file whose contents should be written",
+				MarkdownDescription: "path for the file whose contents should be written",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Path to a local file whose content is uploaded.",
+				MarkdownDescription: "Path to a local file whose content is uploaded.",
+			},
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package tfutils
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// NamingRules are the rules converting the names of Terraform attributes to the
+// names of the API and back.
+type NamingRules struct {
+	// JSONNames are the API names of the attributes as declared in specs/oas.json, by
+	// the NameKey of each attribute name in their path joined with dots, such as
+	// "auth.binddn" for "bindDN". They take precedence over the other rules, which
+	// are used for the attributes not found.
+	JSONNames map[string]string
+	// SnakeToCamelNames are the API names of the attributes that SnakeToCamel does
+	// not guess, such as "file_name" to "file-name".
+	SnakeToCamelNames map[string]string
+	// CamelToSnakeNames are the attribute names of the API names that CamelToSnake
+	// does not guess.
+	CamelToSnakeNames map[string]string
+	// Acronyms are the words written in upper case in API names, such as "id" to "ID".
+	Acronyms map[string]string
+	// IgnoreCaseNames are the attributes, such as labels, whose keys are used as is.
+	IgnoreCaseNames map[string]bool
+}
+
+// DefaultNamingRules returns a copy of the naming rules of the package level functions,
+// which can be changed to build the converter of a resource with different rules.
+func DefaultNamingRules() NamingRules {
+	return NamingRules{
+		SnakeToCamelNames: maps.Clone(snakeToCamelNames),
+		CamelToSnakeNames: maps.Clone(camelToSnakeNames),
+		Acronyms:          maps.Clone(acronyms),
+		IgnoreCaseNames:   maps.Clone(ignoreCaseNames),
+	}
+}
+
+// Converter converts between Terraform models and API values with its naming rules.
+// It keeps no state across conversions, so it can be shared by concurrent requests.
+type Converter struct {
+	rules NamingRules
+	// lenient is true if the values of an API response that cannot be converted are set
+	// to null and reported as warnings, instead of failing the conversion.
+	lenient bool
+}
+
+// NewConverter returns a converter with the given naming rules. The rules must not be
+// changed once the converter is in use.
+func NewConverter(rules NamingRules) *Converter {
+	return &Converter{rules: rules}
+}
+
+// Lenient returns a converter with the same naming rules, which decodes API responses
+// leniently: the values that do not match the type of their attribute, such as after an
+// API upgrade, are set to null and AnyMapToModel returns them as ConversionWarnings.
+func (c *Converter) Lenient() *Converter {
+	return &Converter{rules: c.rules, lenient: true}
+}
+
+// defaultConverter is used by the package level functions.
+var defaultConverter = NewConverter(DefaultNamingRules())
+
+// NameKey normalizes the name of an attribute or a property of the API, so that
+// "label_selector", "label-selector" and "labelSelector" are the same key.
+func NameKey(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// jsonNames returns the API names of the attributes of the object at p, by attribute name,
+// from the JSON names of the rules or converting the attribute names with SnakeToCamel.
+// Attributes with the same key, such as full_roles and fullroles, are converted with
+// SnakeToCamel, as the JSON names cannot tell them apart.
+func (c *Converter) jsonNames(p path.Path, attrNames []string) map[string]string {
+	keys := make(map[string]int, len(attrNames))
+	for _, name := range attrNames {
+		keys[NameKey(name)]++
+	}
+	names := make(map[string]string, len(attrNames))
+	for _, name := range attrNames {
+		names[name] = c.SnakeToCamel(name)
+		if keys[NameKey(name)] > 1 {
+			continue
+		}
+		if jsonName, ok := c.rules.JSONNames[jsonNameKey(p.AtName(name))]; ok {
+			names[name] = jsonName
+		}
+	}
+	return names
+}
+
+// modelJSONNames returns the API names of the attributes of a model struct, by attribute name.
+func (c *Converter) modelJSONNames(modelType reflect.Type) map[string]string {
+	attrValIf := reflect.TypeOf((*attr.Value)(nil)).Elem()
+	attrNames := []string{}
+	for i := range modelType.NumField() {
+		if field := modelType.Field(i); field.Type.Implements(attrValIf) {
+			attrNames = append(attrNames, field.Tag.Get("tfsdk"))
+		}
+	}
+	return c.jsonNames(path.Empty(), attrNames)
+}
+
+// jsonNameKey returns the key of the attribute at p in the JSON names: the keys of the
+// attribute names of its path, joined with dots. Elements of lists, sets and maps have
+// the key of their parent.
+func jsonNameKey(p path.Path) string {
+	keys := make([]string, 0, len(p.Steps()))
+	for _, step := range p.Steps() {
+		if attrName, ok := step.(path.PathStepAttributeName); ok {
+			keys = append(keys, NameKey(string(attrName)))
+		}
+	}
+	return strings.Join(keys, ".")
+}
@@ -0,0 +1,139 @@
+package tfutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ConversionError is an error converting a value between a Terraform model and an API payload.
+type ConversionError struct {
+	// Path is the path of the attribute in the Terraform model, e.g. status.failed_login_since_successful_login.
+	Path path.Path
+	// Pointer is the JSON pointer of the value in the API payload, e.g. /status/failedLoginSinceSuccessfulLogin.
+	Pointer string
+	// Err is the reason of the failure.
+	Err error
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("%s (API payload %s): %v", e.Path, e.Pointer, e.Err)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// ConversionWarnings is returned by a lenient converter when values of the API response
+// could not be converted, and their attributes were set to null.
+type ConversionWarnings struct {
+	Warnings []*ConversionError
+}
+
+func (e *ConversionWarnings) Error() string {
+	msgs := make([]string, len(e.Warnings))
+	for i, w := range e.Warnings {
+		msgs[i] = w.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AddConversionError adds err to diags, as an error of the attribute that failed to convert
+// if err is a ConversionError, or as warnings of the attributes set to null if err is
+// ConversionWarnings. It reports whether an error was added, which is not the case when
+// err is nil or only has warnings.
+func AddConversionError(diags *diag.Diagnostics, summary string, err error) bool {
+	var warnings *ConversionWarnings
+	if err == nil {
+		return false
+	}
+	if errors.As(err, &warnings) {
+		for _, w := range warnings.Warnings {
+			diags.AddAttributeWarning(w.Path, summary,
+				fmt.Sprintf("The value at %s of the API payload cannot be converted and is set to null: %v", w.Pointer, w.Err))
+		}
+		return false
+	}
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || len(convErr.Path.Steps()) == 0 {
+		diags.AddError(summary, err.Error())
+		return true
+	}
+	diags.AddAttributeError(convErr.Path, summary,
+		fmt.Sprintf("The value at %s of the API payload cannot be converted: %v", convErr.Pointer, convErr.Err))
+	return true
+}
+
+// wrapConversionError returns err as a ConversionError at vp, unless it already is one
+// of a nested value.
+func wrapConversionError(vp valuePath, err error) error {
+	var convErr *ConversionError
+	if err == nil || errors.As(err, &convErr) {
+		return err
+	}
+	return &ConversionError{Path: vp.attr, Pointer: vp.pointer, Err: err}
+}
+
+// valuePath is the location of a value being converted, in the Terraform model and in the API payload.
+type valuePath struct {
+	attr    path.Path
+	pointer string
+	// warnings collects the values set to null by a lenient converter, if not nil.
+	warnings *[]*ConversionError
+}
+
+// warn records err as a warning of the conversion, see Converter.Lenient.
+func (vp valuePath) warn(err *ConversionError) {
+	if vp.warnings != nil {
+		*vp.warnings = append(*vp.warnings, err)
+	}
+}
+
+// warningsErr returns the warnings collected at vp, or nil if there is none.
+func (vp valuePath) warningsErr() error {
+	if vp.warnings == nil || len(*vp.warnings) == 0 {
+		return nil
+	}
+	return &ConversionWarnings{Warnings: *vp.warnings}
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// atName returns the location of the attribute name, whose API name is jsonName.
+func (vp valuePath) atName(name, jsonName string) valuePath {
+	return valuePath{attr: vp.attr.AtName(name), pointer: vp.pointer + "/" + pointerEscaper.Replace(jsonName), warnings: vp.warnings}
+}
+
+// atListIndex returns the location of the element of a list at index i in the model,
+// and at index j in the API payload.
+func (vp valuePath) atListIndex(i, j int) valuePath {
+	return valuePath{attr: vp.attr.AtListIndex(i), pointer: vp.pointer + "/" + strconv.Itoa(j), warnings: vp.warnings}
+}
+
+// atTupleIndex returns the location of the element of a tuple at index i in the model,
+// and at index j in the API payload.
+func (vp valuePath) atTupleIndex(i, j int) valuePath {
+	return valuePath{attr: vp.attr.AtTupleIndex(i), pointer: vp.pointer + "/" + strconv.Itoa(j), warnings: vp.warnings}
+}
+
+// atSetElement returns the location of the element of a set with the given value in the
+// model, and at index j in the API payload. Elements of sets are identified by their value,
+// those not converted yet have the path of the set.
+func (vp valuePath) atSetElement(value attr.Value, j int) valuePath {
+	p := vp.attr
+	if value != nil {
+		p = p.AtSetValue(value)
+	}
+	return valuePath{attr: p, pointer: vp.pointer + "/" + strconv.Itoa(j), warnings: vp.warnings}
+}
+
+// atMapKey returns the location of the element of a map with the given key, whose key in
+// the API payload is jsonKey.
+func (vp valuePath) atMapKey(key, jsonKey string) valuePath {
+	return valuePath{attr: vp.attr.AtMapKey(key), pointer: vp.pointer + "/" + pointerEscaper.Replace(jsonKey), warnings: vp.warnings}
+}
@@ -0,0 +1,121 @@
+package tfutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ExtraAttribute is the name of the attribute that collects the keys of an API object
+// without an attribute, when the object has one of dynamic type, see AddExtraAttribute,
+// or of string type, see AddItemExtraAttribute.
+const ExtraAttribute = "extra"
+
+// ExtraModel holds the extra attribute of data sources whose generated model does not have
+// it. It is embedded in their models, next to the generated model, and set by ExtraValue.
+type ExtraModel struct {
+	Extra types.Dynamic `tfsdk:"extra"`
+}
+
+// ExtraValue returns the value of the extra attribute for the API response resp, which is
+// converted to model: the keys of resp that no attribute of model takes.
+func (c *Converter) ExtraValue(resp map[string]any, model any) (types.Dynamic, error) {
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() != reflect.Ptr || modelType.Elem().Kind() != reflect.Struct {
+		return types.DynamicNull(), fmt.Errorf("expected pointer to struct, got %s", modelType.String())
+	}
+	extra, _ := jsonValue(resp, c.modelJSONNames(modelType.Elem()), ExtraAttribute, types.DynamicType)
+	return AnyToDynamic(extra)
+}
+
+// jsonValue returns the value of the attribute name from the API object valuesMap, where
+// names are the API names of the attributes of the object, and whether it was found. The
+// dynamic extra attribute gets the keys of the object that no other attribute takes, unless
+// the object has a key of the same name.
+func jsonValue(valuesMap map[string]any, names map[string]string, name string, attrType attr.Type) (any, bool) {
+	val, found := valuesMap[names[name]]
+	if found || name != ExtraAttribute {
+		return val, found
+	}
+	_, isDynamic := attrType.(basetypes.DynamicType)
+	_, isString := attrType.(basetypes.StringType)
+	if !isDynamic && !isString {
+		return nil, false
+	}
+	taken := make(map[string]bool, len(names))
+	for attrName, jsonName := range names {
+		if attrName != ExtraAttribute {
+			taken[jsonName] = true
+		}
+	}
+	extra := map[string]any{}
+	for k, v := range valuesMap {
+		if !taken[k] {
+			extra[k] = v
+		}
+	}
+	if len(extra) == 0 {
+		return nil, true
+	}
+	if isString {
+		// The keys of a map are sorted, so the same fields give the same string
+		if encoded, err := json.Marshal(extra); err == nil {
+			return string(encoded), true
+		}
+		return nil, true
+	}
+	return extra, true
+}
+
+// AddExtraAttribute adds the extra attribute to the schema of a data source, which gets the
+// keys of the API response that the provider does not know about yet, such as the fields
+// added by an upgrade of EDA. Its model must have an Extra field of type types.Dynamic.
+func AddExtraAttribute(s schema.Schema) schema.Schema {
+	s.Attributes[ExtraAttribute] = schema.DynamicAttribute{
+		Computed:            true,
+		Description:         "The fields of the API response without an attribute, by their API name. Null if there is none.",
+		MarkdownDescription: "The fields of the API response without an attribute, by their API name. `null` if there is none.",
+	}
+	return s
+}
+
+// AddItemExtraAttribute adds the extra attribute to the items of the list or set attribute
+// name of the schema of a data source, when they are objects. Dynamic attributes are not
+// supported inside lists and sets, so it gets the keys of each item without an attribute
+// JSON encoded. The items lose the custom type of the generated schema, which does not
+// know the extra attribute, and are plain objects.
+func AddItemExtraAttribute(s schema.Schema, name string) schema.Schema {
+	extra := schema.StringAttribute{
+		Computed:            true,
+		Description:         "The fields of the item without an attribute, by their API name, JSON encoded. Null if there is none.",
+		MarkdownDescription: "The fields of the item without an attribute, by their API name, JSON encoded, see `jsondecode`. `null` if there is none.",
+	}
+	switch a := s.Attributes[name].(type) {
+	case schema.ListNestedAttribute:
+		a.NestedObject = withExtraAttribute(a.NestedObject, extra)
+		s.Attributes[name] = a
+	case schema.SetNestedAttribute:
+		a.NestedObject = withExtraAttribute(a.NestedObject, extra)
+		s.Attributes[name] = a
+	}
+	return s
+}
+
+// withExtraAttribute returns a copy of the nested object obj with the extra attribute,
+// without custom type.
+func withExtraAttribute(obj schema.NestedAttributeObject, extra schema.StringAttribute) schema.NestedAttributeObject {
+	attrs := maps.Clone(obj.Attributes)
+	if _, ok := attrs[ExtraAttribute]; !ok {
+		attrs[ExtraAttribute] = extra
+	}
+	return schema.NestedAttributeObject{
+		Attributes: attrs,
+		Validators: obj.Validators,
+	}
+}
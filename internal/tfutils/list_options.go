@@ -0,0 +1,204 @@
+package tfutils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListOptionsModel holds the client-side paging, filtering, sorting and projection options
+// of list data sources. It is embedded in their custom models, next to the generated model.
+type ListOptionsModel struct {
+	Attributes     types.List   `tfsdk:"attributes"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Match          types.Map    `tfsdk:"match"`
+	Offset         types.Int64  `tfsdk:"offset"`
+	SortBy         types.String `tfsdk:"sort_by"`
+	SortDescending types.Bool   `tfsdk:"sort_descending"`
+}
+
+// AddListOptions adds the attributes of ListOptionsModel to the schema of a list data source.
+func AddListOptions(s schema.Schema) schema.Schema {
+	s.Attributes["attributes"] = schema.ListAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Description:         "The attributes to keep in each item, the other attributes are null. Defaults to all attributes.",
+		MarkdownDescription: "The attributes to keep in each item, the other attributes are `null`. Defaults to all attributes.",
+	}
+	s.Attributes["limit"] = schema.Int64Attribute{
+		Optional:            true,
+		Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		Description:         "The maximum number of items to keep, after filtering, sorting and skipping offset items.",
+		MarkdownDescription: "The maximum number of items to keep, after filtering, sorting and skipping `offset` items.",
+	}
+	s.Attributes["match"] = schema.MapAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Description:         "Only keep the items whose attributes have the given values, e.g. {severity = \"critical\"}. Nested attributes are given as dotted paths.",
+		MarkdownDescription: "Only keep the items whose attributes have the given values, e.g. `{severity = \"critical\"}`. Nested attributes are given as dotted paths.",
+	}
+	s.Attributes["offset"] = schema.Int64Attribute{
+		Optional:            true,
+		Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		Description:         "The number of items to skip, after filtering and sorting.",
+		MarkdownDescription: "The number of items to skip, after filtering and sorting.",
+	}
+	s.Attributes["sort_by"] = schema.StringAttribute{
+		Optional:            true,
+		Description:         "The attribute to sort the items by, as a dotted path for nested attributes. Numbers are compared numerically, items without the attribute come last.",
+		MarkdownDescription: "The attribute to sort the items by, as a dotted path for nested attributes. Numbers are compared numerically, items without the attribute come last.",
+	}
+	s.Attributes["sort_descending"] = schema.BoolAttribute{
+		Optional:            true,
+		Description:         "If true, the items are sorted in descending order of sort_by.",
+		MarkdownDescription: "If true, the items are sorted in descending order of `sort_by`.",
+	}
+	return s
+}
+
+// Apply filters, sorts, pages and projects the items of an API result, in that order.
+// The items are objects as decoded from JSON, attributes are referred to by their
+// snake_case name and converted to the keys of the API with the naming rules of c,
+// the items being the elements of the attribute listAttr in its JSON names, as with
+// the converters of list responses. An empty listAttr looks the items up at the root.
+func (o ListOptionsModel) Apply(ctx context.Context, c *Converter, listAttr string, items []any) ([]any, error) {
+	root := path.Empty()
+	if listAttr != "" {
+		root = path.Root(listAttr)
+	}
+	match := map[string]string{}
+	if !o.Match.IsNull() && !o.Match.IsUnknown() {
+		if d := o.Match.ElementsAs(ctx, &match, false); d.HasError() {
+			return nil, fmt.Errorf("failed to get match: %v", d)
+		}
+	}
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		if c.listItemMatches(root, item, match) {
+			out = append(out, item)
+		}
+	}
+
+	if sortBy := o.SortBy.ValueString(); sortBy != "" {
+		desc := o.SortDescending.ValueBool()
+		sort.SliceStable(out, func(i, j int) bool {
+			vi, foundi := c.listItemField(root, out[i], sortBy)
+			vj, foundj := c.listItemField(root, out[j], sortBy)
+			if !foundi || !foundj {
+				return foundi && !foundj
+			}
+			if desc {
+				return compareAny(vj, vi) < 0
+			}
+			return compareAny(vi, vj) < 0
+		})
+	}
+
+	if offset := int(o.Offset.ValueInt64()); offset > 0 {
+		out = out[min(offset, len(out)):]
+	}
+	if !o.Limit.IsNull() && !o.Limit.IsUnknown() {
+		out = out[:min(int(o.Limit.ValueInt64()), len(out))]
+	}
+
+	if !o.Attributes.IsNull() && !o.Attributes.IsUnknown() {
+		keep := []string{}
+		if d := o.Attributes.ElementsAs(ctx, &keep, false); d.HasError() {
+			return nil, fmt.Errorf("failed to get attributes: %v", d)
+		}
+		for i, item := range out {
+			out[i] = c.projectListItem(root, item, keep)
+		}
+	}
+	return out, nil
+}
+
+// listItemMatches reports whether every attribute of match has the given value in item.
+func (c *Converter) listItemMatches(root path.Path, item any, match map[string]string) bool {
+	for name, want := range match {
+		val, found := c.listItemField(root, item, name)
+		if !found || fmt.Sprint(val) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// listItemField returns the value at the dotted path of snake_case attribute names in item,
+// whose attribute path is root.
+func (c *Converter) listItemField(root path.Path, item any, dotted string) (any, bool) {
+	val := item
+	p := root
+	for _, name := range strings.Split(dotted, ".") {
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		key, found := c.listItemKey(p, obj, name)
+		if !found || obj[key] == nil {
+			return nil, false
+		}
+		val = obj[key]
+		p = p.AtName(name)
+	}
+	return val, true
+}
+
+// listItemKey returns the API key in obj of the attribute name of the object at p: the name
+// itself, such as the keys of labels, or its API name with the naming rules of the converter.
+func (c *Converter) listItemKey(p path.Path, obj map[string]any, name string) (string, bool) {
+	if _, ok := obj[name]; ok {
+		return name, true
+	}
+	key := c.jsonNames(p, []string{name})[name]
+	if _, ok := obj[key]; ok {
+		return key, true
+	}
+	return "", false
+}
+
+// projectListItem returns a copy of item, whose attribute path is root, with only the
+// given top level attributes.
+func (c *Converter) projectListItem(root path.Path, item any, keep []string) any {
+	obj, ok := item.(map[string]any)
+	if !ok {
+		return item
+	}
+	out := make(map[string]any, len(keep))
+	for _, name := range keep {
+		if key, found := c.listItemKey(root, obj, name); found {
+			out[key] = obj[key]
+		}
+	}
+	return out
+}
+
+// compareAny compares two values decoded from JSON, numbers numerically and
+// anything else by its string form.
+func compareAny(a, b any) int {
+	if isJSONNumber(a) && isJSONNumber(b) {
+		fa, erra := NumToBigFloat(a)
+		fb, errb := NumToBigFloat(b)
+		if erra == nil && errb == nil {
+			return fa.Cmp(fb)
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// isJSONNumber reports whether v is a number decoded from JSON, with or without UseNumber.
+func isJSONNumber(v any) bool {
+	switch v.(type) {
+	case json.Number, float64:
+		return true
+	}
+	return false
+}
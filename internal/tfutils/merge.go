@@ -0,0 +1,164 @@
+package tfutils
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FieldMode tells MergeAnyMapToModel how to update an attribute from an API response.
+type FieldMode int
+
+const (
+	// FieldMerged attributes take the value of the response, and keep their
+	// planned or prior value when the response omits them.
+	FieldMerged FieldMode = iota
+	// FieldWriteOnly attributes are not returned by the API, such as passwords,
+	// they always keep their planned or prior value.
+	FieldWriteOnly
+	// FieldServerOwned attributes are set by the API only, such as status,
+	// they take the value of the response and are null when it omits them.
+	FieldServerOwned
+)
+
+// fieldModeTags are the values of the `tfutils` struct tag of a model field, which sets its mode.
+var fieldModeTags = map[string]FieldMode{
+	"merged":       FieldMerged,
+	"write_only":   FieldWriteOnly,
+	"server_owned": FieldServerOwned,
+}
+
+// FieldModes are the modes of the attributes of a model, by attribute path, such as
+// "password" or "auth.bind_credential". They override the `tfutils` struct tags, so
+// that the modes of the attributes of generated models can be set too.
+type FieldModes map[string]FieldMode
+
+// MergeAnyMapToModel updates a Terraform model holding the planned or prior values of
+// a resource with an API response. Unlike AnyMapToModel, the attributes the response
+// omits keep their value instead of becoming null, down to the attributes of nested
+// objects, unless they are server owned. Unknown values left are set to null. The extra
+// attribute and lenient converters are handled as in AnyMapToModel.
+func MergeAnyMapToModel(ctx context.Context, resp map[string]any, model any, modes FieldModes) error {
+	return defaultConverter.MergeAnyMapToModel(ctx, resp, model, modes)
+}
+
+// MergeAnyMapToModel is MergeAnyMapToModel with the naming rules of the converter.
+func (c *Converter) MergeAnyMapToModel(ctx context.Context, resp map[string]any, model any, modes FieldModes) error {
+	modelType := reflect.TypeOf(model)
+	modelValue := reflect.ValueOf(model)
+	tflog.Debug(ctx, "MergeAnyMapToModel()", map[string]any{
+		"type":  modelType.String(),
+		"kind":  modelType.Kind().String(),
+		"modes": modes,
+	})
+
+	// Check if the type is a pointer to a struct
+	if modelType.Kind() != reflect.Ptr || modelType.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected pointer to struct, got %s", modelType.String())
+	}
+
+	attrValIf := reflect.TypeOf((*attr.Value)(nil)).Elem()
+	names := c.modelJSONNames(modelType.Elem())
+	modelPath := valuePath{warnings: &[]*ConversionError{}}
+	for i := range modelType.Elem().NumField() {
+		field := modelType.Elem().Field(i)
+		// Check if the model struct field implements attr.Value
+		if !field.Type.Implements(attrValIf) {
+			tflog.Debug(ctx, fmt.Sprintf("MergeAnyMapToModel()::%s.%s does not implement attr.Value",
+				modelType.Elem().String(), field.Name))
+			continue
+		}
+		name := field.Tag.Get("tfsdk")
+		mode := FieldMerged
+		if tag := field.Tag.Get("tfutils"); tag != "" {
+			tagMode, ok := fieldModeTags[tag]
+			if !ok {
+				return fmt.Errorf("%s.%s: unknown tfutils tag %q", modelType.Elem().String(), field.Name, tag)
+			}
+			mode = tagMode
+		}
+		if m, ok := modes[name]; ok {
+			mode = m
+		}
+
+		attrVal := modelValue.Elem().Field(i).Interface().(attr.Value)
+		val, found := jsonValue(resp, names, name, attrVal.Type(ctx))
+		newVal, err := c.mergeValue(ctx, modelPath.atName(name, names[name]), attrVal, val, found, mode, modes)
+		if err != nil {
+			return err
+		}
+		// Set the new value to the model field
+		modelValue.Elem().Field(i).Set(reflect.ValueOf(newVal))
+	}
+	return modelPath.warningsErr()
+}
+
+// mergeValue returns the value of the attribute at vp from its current value and the value of
+// the API response, if found. Objects present on both sides are merged attribute by attribute.
+func (c *Converter) mergeValue(ctx context.Context, vp valuePath, cur attr.Value, val any, found bool,
+	mode FieldMode, modes FieldModes) (attr.Value, error) {
+	switch {
+	case mode == FieldWriteOnly || !found && mode == FieldMerged:
+		if cur.IsUnknown() {
+			return c.newValue(ctx, vp, cur.Type(ctx), nil, false)
+		}
+		return cur, nil
+	case mode == FieldServerOwned:
+		return c.newValue(ctx, vp, cur.Type(ctx), val, false)
+	}
+
+	valuesMap, isMap := val.(map[string]any)
+	objValuable, isObj := cur.(basetypes.ObjectValuable)
+	if !isMap || !isObj || cur.IsNull() || cur.IsUnknown() {
+		return c.newValue(ctx, vp, cur.Type(ctx), val, false)
+	}
+
+	objVal, d := objValuable.ToObjectValue(ctx)
+	if d.HasError() {
+		return nil, fmt.Errorf("failed to get obj value from obj valuable: %v", d)
+	}
+	attrs := make(map[string]attr.Value, len(objVal.Attributes()))
+	names := c.jsonNames(vp.attr, slices.Collect(maps.Keys(objVal.Attributes())))
+	for name, attrVal := range objVal.Attributes() {
+		attrPath := vp.atName(name, names[name])
+		attrMode := FieldMerged
+		if m, ok := modes[attrPath.attr.String()]; ok {
+			attrMode = m
+		}
+		attrRespVal, attrFound := jsonValue(valuesMap, names, name, attrVal.Type(ctx))
+		if c.rules.IgnoreCaseNames[name] && attrMode != FieldWriteOnly && attrFound {
+			// Labels and annotations keep their keys, as newValue does for objects
+			newVal, err := c.newValue(ctx, attrPath, attrVal.Type(ctx), attrRespVal, true)
+			if err != nil {
+				return nil, err
+			}
+			attrs[name] = newVal
+			continue
+		}
+		newVal, err := c.mergeValue(ctx, attrPath, attrVal, attrRespVal, attrFound, attrMode, modes)
+		if err != nil {
+			return nil, err
+		}
+		attrs[name] = newVal
+	}
+
+	newObjVal, d := types.ObjectValue(objVal.AttributeTypes(ctx), attrs)
+	if d.HasError() {
+		return nil, fmt.Errorf("failed to create value from obj at %s: %v", vp.attr, d)
+	}
+	if objTypable, ok := cur.Type(ctx).(basetypes.ObjectTypable); ok {
+		newVal, d := objTypable.ValueFromObject(ctx, newObjVal)
+		if d.HasError() {
+			return nil, fmt.Errorf("failed to create new value from obj at %s: %v", vp.attr, d)
+		}
+		return newVal, nil
+	}
+	return newObjVal, nil
+}
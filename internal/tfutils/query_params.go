@@ -0,0 +1,143 @@
+package tfutils
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// QueryParam is a query parameter of an API operation, as declared in specs/oas.json.
+type QueryParam struct {
+	// Name is the name of the parameter in the API.
+	Name string
+	// Type is the JSON schema type of the parameter: string, boolean, integer, number or array.
+	Type string
+	// Style is the OpenAPI serialization style of the parameter: form, spaceDelimited or pipeDelimited.
+	Style string
+	// Explode is true when the items of an array parameter are sent as repeated keys,
+	// and false when they are joined into a single value.
+	Explode bool
+}
+
+// queryParamDelimiters are the delimiters of the items of non exploded array parameters, by style.
+var queryParamDelimiters = map[string]string{
+	"":               ",",
+	"form":           ",",
+	"spaceDelimited": " ",
+	"pipeDelimited":  "|",
+}
+
+// ModelToQueryValues encodes the attributes of a Terraform model as the given query parameters.
+// Attributes are matched to parameters by name, ignoring case, dashes and underscores, and the
+// attributes without a parameter are left out. Null and unknown attributes are not sent.
+func ModelToQueryValues(ctx context.Context, model any, params []QueryParam) (url.Values, error) {
+	typ := reflect.TypeOf(model)
+	val := reflect.ValueOf(model)
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected pointer to struct, got %s", typ.String())
+	}
+
+	// Some models and operations have the same attribute or parameter under two spellings,
+	// such as full_roles and fullroles, or full-roles and fullRoles. The set attributes are
+	// matched to the parameters with the same key in order.
+	attrs := map[string][]attr.Value{}
+	attrValIf := reflect.TypeOf((*attr.Value)(nil)).Elem()
+	for i := range typ.Elem().NumField() {
+		field := typ.Elem().Field(i)
+		if !field.Type.Implements(attrValIf) {
+			continue
+		}
+		attrVal := val.Elem().Field(i).Interface().(attr.Value)
+		if attrVal.IsNull() || attrVal.IsUnknown() {
+			continue
+		}
+		key := NameKey(field.Tag.Get("tfsdk"))
+		attrs[key] = append(attrs[key], attrVal)
+	}
+
+	query := url.Values{}
+	for _, param := range params {
+		key := NameKey(param.Name)
+		if len(attrs[key]) == 0 {
+			continue
+		}
+		attrVal := attrs[key][0]
+		attrs[key] = attrs[key][1:]
+		values, err := queryParamValues(ctx, param, attrVal)
+		if err != nil {
+			return nil, fmt.Errorf("query parameter %s: %w", param.Name, err)
+		}
+		query[param.Name] = values
+	}
+	return query, nil
+}
+
+// queryParamValues returns the values of a query parameter, one per item for exploded arrays.
+func queryParamValues(ctx context.Context, param QueryParam, attrVal attr.Value) ([]string, error) {
+	if param.Type != "array" {
+		if err := queryScalarCheck(ctx, param.Type, attrVal); err != nil {
+			return nil, err
+		}
+		return []string{StringValue(attrVal)}, nil
+	}
+
+	var elems []attr.Value
+	switch v := attrVal.(type) {
+	case basetypes.ListValue:
+		elems = v.Elements()
+	case basetypes.SetValue:
+		elems = v.Elements()
+	case basetypes.TupleValue:
+		elems = v.Elements()
+	default:
+		// A single value is an array of one item
+		elems = []attr.Value{attrVal}
+	}
+	items := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		if elem.IsNull() || elem.IsUnknown() {
+			continue
+		}
+		if err := queryScalarCheck(ctx, "", elem); err != nil {
+			return nil, err
+		}
+		items = append(items, StringValue(elem))
+	}
+	if param.Explode {
+		return items, nil
+	}
+	delim, ok := queryParamDelimiters[param.Style]
+	if !ok {
+		return nil, fmt.Errorf("unsupported style %q", param.Style)
+	}
+	return []string{strings.Join(items, delim)}, nil
+}
+
+// queryScalarCheck checks that a value can be sent as a scalar parameter of the given type.
+// Any scalar is accepted for string parameters, or when the type is not set.
+func queryScalarCheck(ctx context.Context, paramType string, attrVal attr.Value) error {
+	var ok bool
+	switch v := attrVal.(type) {
+	case basetypes.StringValue:
+		ok = paramType == "string"
+	case basetypes.BoolValue:
+		ok = paramType == "string" || paramType == "boolean"
+	case basetypes.Int32Value, basetypes.Int64Value:
+		ok = paramType == "string" || paramType == "integer" || paramType == "number"
+	case basetypes.NumberValue:
+		ok = paramType == "string" || paramType == "number" || paramType == "integer" && v.ValueBigFloat().IsInt()
+	case basetypes.Float32Value, basetypes.Float64Value:
+		ok = paramType == "string" || paramType == "number"
+	default:
+		return fmt.Errorf("expected a scalar value, got %s", attrVal.Type(ctx))
+	}
+	if !ok && paramType != "" {
+		return fmt.Errorf("expected a value of type %s, got %s", paramType, attrVal.Type(ctx))
+	}
+	return nil
+}
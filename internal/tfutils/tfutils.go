@@ -2,15 +2,17 @@ package tfutils
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"math/big"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
-	"sync"
-	"sync/atomic"
 	"unicode"
 
 	"github.com/davecgh/go-spew/spew"
@@ -21,10 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// The default naming rules, see DefaultNamingRules.
 var (
-	visitCounter      int64
-	mapLock           sync.Mutex
-	ignoreCaseVisitor = map[string]bool{}
 	snakeToCamelNames = map[string]string{
 		"alarm_name":                 "alarm-name",
 		"app_id":                     "appId",
@@ -118,28 +118,6 @@ var (
 	}
 )
 
-func newVisitID(prefix string) string {
-	return prefix + "-" + strconv.FormatInt(atomic.AddInt64(&visitCounter, 1), 10)
-}
-
-func getVisited(key string) bool {
-	mapLock.Lock()
-	defer mapLock.Unlock()
-	return ignoreCaseVisitor[key]
-}
-
-func setVisited(key string, val bool) {
-	mapLock.Lock()
-	defer mapLock.Unlock()
-	ignoreCaseVisitor[key] = val
-}
-
-func clearVisited(key string) {
-	mapLock.Lock()
-	defer mapLock.Unlock()
-	delete(ignoreCaseVisitor, key)
-}
-
 // SnakeToCamel converts a snake_case string to camelCase
 // |--------------------------------|
 // |            Examples            |
@@ -153,11 +131,16 @@ func clearVisited(key string) {
 // | "vlan_id"      | "vlanID"      |
 // |----------------|---------------|
 func SnakeToCamel(str string) string {
+	return defaultConverter.SnakeToCamel(str)
+}
+
+// SnakeToCamel converts a snake_case string to camelCase with the naming rules of the converter.
+func (c *Converter) SnakeToCamel(str string) string {
 	if str == "" {
 		return ""
 	}
 	// Check for special snake_case names first
-	if val, ok := snakeToCamelNames[str]; ok {
+	if val, ok := c.rules.SnakeToCamelNames[str]; ok {
 		return val
 	}
 	parts := strings.Split(str, "_")
@@ -170,7 +153,7 @@ func SnakeToCamel(str string) string {
 		}
 		// Match with special acronyms (e.g. "mtu", "id", etc.)
 		lower := strings.ToLower(parts[i])
-		if val, ok := acronyms[lower]; ok {
+		if val, ok := c.rules.Acronyms[lower]; ok {
 			result = append(result, val)
 			continue
 		}
@@ -204,15 +187,21 @@ func SnakeToCamel(str string) string {
 // | "vlanID"      | "vlan_id"      |
 // |---------------|----------------|
 func CamelToSnake(str string) string {
+	return defaultConverter.CamelToSnake(str)
+}
+
+var camelWordRe = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// CamelToSnake converts a camelCase string to snake_case with the naming rules of the converter.
+func (c *Converter) CamelToSnake(str string) string {
 	if str == "" {
 		return ""
 	}
 	// Check for special camelCase names first
-	if val, ok := camelToSnakeNames[str]; ok {
+	if val, ok := c.rules.CamelToSnakeNames[str]; ok {
 		return val
 	}
-	re := regexp.MustCompile(`([a-z0-9])([A-Z])`)
-	str = re.ReplaceAllString(str, "${1}_${2}")
+	str = camelWordRe.ReplaceAllString(str, "${1}_${2}")
 
 	return strings.ToLower(str)
 }
@@ -296,44 +285,151 @@ func newNullValue(ctx context.Context, attrValIf attr.Value) (attr.Value, error)
 	}
 }
 
-// Converts any numeric value to int64. It supports various types including int, uint, string, float32, and float64.
-// This is required during parsing API responses in json which return numbers as float64, to a terraform basetype.
-func NumToInt64(value any) (int64, error) {
+// numberPrec is the precision of the big.Float values parsed from JSON numbers,
+// the same as the one Terraform uses for number values.
+const numberPrec = 512
+
+// NumToBigFloat converts any numeric value to a *big.Float. It supports json.Number, as decoded
+// from API responses, the Go integer and float types, and strings holding a number.
+func NumToBigFloat(value any) (*big.Float, error) {
 	switch v := value.(type) {
+	case *big.Float:
+		return v, nil
+	case json.Number:
+		return parseBigFloat(string(v))
+	case string:
+		return parseBigFloat(v)
 	case int:
-		return int64(v), nil
+		return new(big.Float).SetInt64(int64(v)), nil
 	case int8:
-		return int64(v), nil
+		return new(big.Float).SetInt64(int64(v)), nil
 	case int16:
-		return int64(v), nil
+		return new(big.Float).SetInt64(int64(v)), nil
 	case int32:
-		return int64(v), nil
+		return new(big.Float).SetInt64(int64(v)), nil
 	case int64:
-		return v, nil
+		return new(big.Float).SetInt64(v), nil
 	case uint:
-		return int64(v), nil
+		return new(big.Float).SetUint64(uint64(v)), nil
 	case uint8:
-		return int64(v), nil
+		return new(big.Float).SetUint64(uint64(v)), nil
 	case uint16:
-		return int64(v), nil
+		return new(big.Float).SetUint64(uint64(v)), nil
 	case uint32:
-		return int64(v), nil
+		return new(big.Float).SetUint64(uint64(v)), nil
 	case uint64:
-		return int64(v), nil
-	case string:
-		return strconv.ParseInt(v, 10, 64)
+		return new(big.Float).SetUint64(v), nil
 	case float32:
-		return int64(v), nil
+		return bigFloatFromFloat(float64(v), 32)
 	case float64:
-		return int64(v), nil
+		return bigFloatFromFloat(v, 64)
 	default:
-		return 0, fmt.Errorf("unsupported type: %T", value)
+		return nil, fmt.Errorf("expected a number, got %T", value)
+	}
+}
+
+func parseBigFloat(s string) (*big.Float, error) {
+	f, _, err := big.ParseFloat(s, 10, numberPrec, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return f, nil
+}
+
+// bigFloatFromFloat parses the shortest decimal representation of a float, so that 0.1 gives
+// the same number as the literal 0.1 in a Terraform configuration.
+func bigFloatFromFloat(v float64, bitSize int) (*big.Float, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("invalid number %v", v)
+	}
+	return parseBigFloat(strconv.FormatFloat(v, 'g', -1, bitSize))
+}
+
+// Converts any numeric value to int64. It supports json.Number, the Go integer and float types,
+// and strings, see NumToBigFloat. Values with a fractional part or out of the int64 range are errors.
+func NumToInt64(value any) (int64, error) {
+	f, err := NumToBigFloat(value)
+	if err != nil {
+		return 0, err
+	}
+	if !f.IsInt() {
+		return 0, fmt.Errorf("%s is not an integer", f.Text('g', -1))
+	}
+	i, acc := f.Int64()
+	if acc != big.Exact {
+		return 0, fmt.Errorf("%s overflows int64", f.Text('f', 0))
+	}
+	return i, nil
+}
+
+// numToInt32 converts any numeric value to int32, see NumToInt64.
+func numToInt32(value any) (int32, error) {
+	i, err := NumToInt64(value)
+	if err != nil {
+		return 0, err
+	}
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return 0, fmt.Errorf("%d overflows int32", i)
+	}
+	return int32(i), nil
+}
+
+// numToFloat64 converts any numeric value to float64, values out of the float64 range are errors.
+// Values within the range are rounded to the nearest float64.
+func numToFloat64(value any) (float64, error) {
+	f, err := NumToBigFloat(value)
+	if err != nil {
+		return 0, err
+	}
+	f64, _ := f.Float64()
+	if math.IsInf(f64, 0) {
+		return 0, fmt.Errorf("%s overflows float64", f.Text('g', 10))
 	}
+	return f64, nil
+}
+
+// numToFloat32 converts any numeric value to float32, see numToFloat64.
+func numToFloat32(value any) (float32, error) {
+	f, err := NumToBigFloat(value)
+	if err != nil {
+		return 0, err
+	}
+	f32, _ := f.Float32()
+	if math.IsInf(float64(f32), 0) {
+		return 0, fmt.Errorf("%s overflows float32", f.Text('g', 10))
+	}
+	return f32, nil
+}
+
+// bigFloatToAny converts a number to a Go value encoding/json encodes without loss: an int64 for
+// integers in range, a float64 for the other numbers whose float64 has the same decimal
+// representation, and a json.Number otherwise.
+func bigFloatToAny(f *big.Float) any {
+	if f.IsInt() {
+		if i, acc := f.Int64(); acc == big.Exact {
+			return i
+		}
+		return json.Number(f.Text('f', 0))
+	}
+	if f64, _ := f.Float64(); !math.IsInf(f64, 0) {
+		if back, err := bigFloatFromFloat(f64, 64); err == nil && back.Cmp(f) == 0 {
+			return f64
+		}
+	}
+	return json.Number(f.Text('g', -1))
 }
 
 // Creates a new attr.Value from the given attr.Type and any value.
 // If val is nil, it returns a null value of the corresponding attr.Type.
-func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string) (attr.Value, error) {
+// If keepKeys is true, the keys of maps are kept as is, e.g. inside labels.
+// A lenient converter returns a null value instead of failing, and adds a warning at vp.
+func (c *Converter) newValue(ctx context.Context, vp valuePath, attrTypeIf attr.Type, val any, keepKeys bool) (newVal attr.Value, err error) {
+	defer func() {
+		err = wrapConversionError(vp, err)
+		if err != nil && c.lenient {
+			newVal, err = c.skipValue(ctx, vp, attrTypeIf, err)
+		}
+	}()
 	if attrTypeIf == nil {
 		return nil, errors.New("attr type is nil")
 	}
@@ -351,36 +447,36 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 		if val == nil {
 			return types.DynamicNull(), nil
 		}
-		attrVal, ok := val.(attr.Value)
-		if !ok {
-			return nil, fmt.Errorf("expected attr.Value, got %T", val)
+		if attrVal, ok := val.(attr.Value); ok {
+			return types.DynamicValue(attrVal), nil
 		}
-		return types.DynamicValue(attrVal), nil
+		// Free-form JSON, such as the spec of a CR, its type is inferred from the value
+		return AnyToDynamic(val)
 	case basetypes.Float32Type:
 		if val == nil {
 			return types.Float32Null(), nil
 		}
-		float32Val, ok := val.(float32)
-		if !ok {
-			return nil, fmt.Errorf("expected float32, got %T", val)
+		float32Val, err := numToFloat32(val)
+		if err != nil {
+			return nil, fmt.Errorf("expected float32: %w", err)
 		}
 		return types.Float32Value(float32Val), nil
 	case basetypes.Float64Type:
 		if val == nil {
 			return types.Float64Null(), nil
 		}
-		float64Val, ok := val.(float64)
-		if !ok {
-			return nil, fmt.Errorf("expected float64, got %T", val)
+		float64Val, err := numToFloat64(val)
+		if err != nil {
+			return nil, fmt.Errorf("expected float64: %w", err)
 		}
 		return types.Float64Value(float64Val), nil
 	case basetypes.Int32Type:
 		if val == nil {
 			return types.Int32Null(), nil
 		}
-		int32Val, ok := val.(int32)
-		if !ok {
-			return nil, fmt.Errorf("expected int32, got %T", val)
+		int32Val, err := numToInt32(val)
+		if err != nil {
+			return nil, fmt.Errorf("expected int32: %w", err)
 		}
 		return types.Int32Value(int32Val), nil
 	case basetypes.Int64Type:
@@ -389,7 +485,7 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 		}
 		int64Val, err := NumToInt64(val)
 		if err != nil {
-			return nil, fmt.Errorf("expected int64, got %T", val)
+			return nil, fmt.Errorf("expected int64: %w", err)
 		}
 		return types.Int64Value(int64Val), nil
 	case basetypes.ListType:
@@ -401,8 +497,8 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 			return nil, fmt.Errorf("expected []any, got %T", val)
 		}
 		var newValList = make([]attr.Value, 0)
-		for _, v := range valuesList {
-			newVal, err := newValue(ctx, attrType.ElementType(), v, visitId)
+		for i, v := range valuesList {
+			newVal, err := c.newValue(ctx, vp.atListIndex(i, i), attrType.ElementType(), v, keepKeys)
 			if err != nil {
 				return nil, err
 			}
@@ -422,37 +518,26 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 			return nil, fmt.Errorf("expected map[string]any, got %T", val)
 		}
 		tflog.Trace(ctx, "newValue()::MapType case",
-			map[string]any{"valuesMap": spew.Sdump(valuesMap), "visitId": visitId})
+			map[string]any{"valuesMap": spew.Sdump(valuesMap), "keepKeys": keepKeys})
 
 		newValMap := make(map[string]attr.Value)
-		oldVisitId := visitId
 		for k, v := range valuesMap {
-			if !getVisited(visitId) && ignoreCaseNames[k] {
-				visitId = newVisitID(k)
-				setVisited(visitId, true)
-			}
+			elemKeepKeys := keepKeys || c.rules.IgnoreCaseNames[k]
 			tflog.Trace(ctx, "newValue()::MapType case: Processing valuesMap",
-				map[string]any{"name": k, "visitId": visitId})
+				map[string]any{"name": k, "keepKeys": elemKeepKeys})
 
-			newVal, err := newValue(ctx, attrType.ElementType(), v, visitId)
+			key := k
+			if !elemKeepKeys {
+				key = c.SnakeToCamel(k)
+			}
+			newVal, err := c.newValue(ctx, vp.atMapKey(key, k), attrType.ElementType(), v, elemKeepKeys)
 			if err != nil {
 				return nil, err
 			}
-			if getVisited(visitId) {
-				newValMap[k] = newVal
-			} else {
-				newValMap[SnakeToCamel(k)] = newVal
-			}
-			if visitId != oldVisitId {
-				tflog.Trace(ctx, "newValue()::MapType case: Deleting visitId",
-					map[string]any{"name": k, "oldVisitId": oldVisitId, "newVisitId": visitId})
-
-				clearVisited(visitId)
-				visitId = oldVisitId
-			}
+			newValMap[key] = newVal
 		}
 		tflog.Trace(ctx, "newValue()::MapType case: Constructing MapValue",
-			map[string]any{"newValMap": spew.Sdump(newValMap), "visitId": visitId})
+			map[string]any{"newValMap": spew.Sdump(newValMap)})
 
 		mapVal, d := types.MapValue(attrType.ElemType, newValMap)
 		if d.HasError() {
@@ -463,9 +548,9 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 		if val == nil {
 			return types.NumberNull(), nil
 		}
-		numVal, ok := val.(*big.Float)
-		if !ok {
-			return nil, fmt.Errorf("expected *big.Float, got %T", val)
+		numVal, err := NumToBigFloat(val)
+		if err != nil {
+			return nil, fmt.Errorf("expected number: %w", err)
 		}
 		return types.NumberValue(numVal), nil
 	case basetypes.ObjectType:
@@ -477,34 +562,25 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 			return nil, fmt.Errorf("expected map[string]any, got %T", val)
 		}
 		tflog.Trace(ctx, "newValue()::ObjectType case",
-			map[string]any{"valuesMap": spew.Sdump(valuesMap), "visitId": visitId})
+			map[string]any{"valuesMap": spew.Sdump(valuesMap), "keepKeys": keepKeys})
 
 		newValMap := make(map[string]attr.Value)
-		oldVisitId := visitId
+		names := c.jsonNames(vp.attr, slices.Collect(maps.Keys(attrType.AttributeTypes())))
 		// Iterate over all the attributes of the object
 		for name, aType := range attrType.AttributeTypes() {
-			if !getVisited(visitId) && ignoreCaseNames[name] {
-				visitId = newVisitID(name)
-				setVisited(visitId, true)
-			}
+			attrKeepKeys := keepKeys || c.rules.IgnoreCaseNames[name]
 			tflog.Trace(ctx, "newValue()::ObjectType case: Processing attributes",
-				map[string]any{"attrName": name, "visitId": visitId})
+				map[string]any{"attrName": name, "keepKeys": attrKeepKeys})
 
-			newVal, err := newValue(ctx, aType, valuesMap[SnakeToCamel(name)], visitId)
+			attrVal, _ := jsonValue(valuesMap, names, name, aType)
+			newVal, err := c.newValue(ctx, vp.atName(name, names[name]), aType, attrVal, attrKeepKeys)
 			if err != nil {
 				return nil, err
 			}
 			newValMap[name] = newVal
-			if visitId != oldVisitId {
-				tflog.Trace(ctx, "newValue()::ObjectType case: Deleting visitId",
-					map[string]any{"attrName": name, "oldVisitId": oldVisitId, "newVisitId": visitId})
-
-				clearVisited(visitId)
-				visitId = oldVisitId
-			}
 		}
 		tflog.Trace(ctx, "newValue()::ObjectType case: Constructing ObjectValue",
-			map[string]any{"newValMap": spew.Sdump(newValMap), "visitId": visitId})
+			map[string]any{"newValMap": spew.Sdump(newValMap)})
 
 		objVal, d := types.ObjectValue(attrType.AttributeTypes(), newValMap)
 		if d.HasError() {
@@ -520,8 +596,8 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 			return nil, fmt.Errorf("expected []any, got %T", val)
 		}
 		var newValList = make([]attr.Value, 0)
-		for _, v := range valuesList {
-			newVal, err := newValue(ctx, attrType.ElementType(), v, visitId)
+		for i, v := range valuesList {
+			newVal, err := c.newValue(ctx, vp.atSetElement(nil, i), attrType.ElementType(), v, keepKeys)
 			if err != nil {
 				return nil, err
 			}
@@ -554,33 +630,24 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 			return nil, fmt.Errorf("expected map[string]any, got %T", val)
 		}
 		tflog.Trace(ctx, "newValue()::ObjectTypable case",
-			map[string]any{"valuesMap": spew.Sdump(valuesMap), "visitId": visitId})
+			map[string]any{"valuesMap": spew.Sdump(valuesMap), "keepKeys": keepKeys})
 
 		newValMap := make(map[string]attr.Value)
-		oldVisitId := visitId
+		names := c.jsonNames(vp.attr, slices.Collect(maps.Keys(objVal.AttributeTypes(ctx))))
 		for name, aType := range objVal.AttributeTypes(ctx) {
-			if !getVisited(visitId) && ignoreCaseNames[name] {
-				visitId = newVisitID(name)
-				setVisited(visitId, true)
-			}
+			attrKeepKeys := keepKeys || c.rules.IgnoreCaseNames[name]
 			tflog.Trace(ctx, "newValue()::ObjectTypable case: Processing attributes",
-				map[string]any{"attrName": name, "visitId": visitId})
+				map[string]any{"attrName": name, "keepKeys": attrKeepKeys})
 
-			newVal, err := newValue(ctx, aType, valuesMap[SnakeToCamel(name)], visitId)
+			attrVal, _ := jsonValue(valuesMap, names, name, aType)
+			newVal, err := c.newValue(ctx, vp.atName(name, names[name]), aType, attrVal, attrKeepKeys)
 			if err != nil {
 				return nil, err
 			}
 			newValMap[name] = newVal
-			if visitId != oldVisitId {
-				tflog.Trace(ctx, "newValue()::ObjectTypable case: Deleting visitId",
-					map[string]any{"attrName": name, "oldVisitId": oldVisitId, "newVisitId": visitId})
-
-				clearVisited(visitId)
-				visitId = oldVisitId
-			}
 		}
 		tflog.Trace(ctx, "newValue()::ObjectTypable case: Constructing ObjectValue",
-			map[string]any{"newValMap": spew.Sdump(newValMap), "visitId": visitId})
+			map[string]any{"newValMap": spew.Sdump(newValMap)})
 
 		newObjVal, d := types.ObjectValue(objVal.AttributeTypes(ctx), newValMap)
 		if d.HasError() {
@@ -596,7 +663,32 @@ func newValue(ctx context.Context, attrTypeIf attr.Type, val any, visitId string
 	}
 }
 
-func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any, error) {
+// skipValue returns the null value of the attribute at vp that failed to convert with err,
+// and records err as a warning. It returns err if the null value cannot be created either.
+func (c *Converter) skipValue(ctx context.Context, vp valuePath, attrType attr.Type, err error) (attr.Value, error) {
+	strict := &Converter{rules: c.rules}
+	nullVal, nullErr := strict.newValue(ctx, vp, attrType, nil, false)
+	if nullErr != nil {
+		return nil, err
+	}
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) {
+		convErr = &ConversionError{Path: vp.attr, Pointer: vp.pointer, Err: err}
+	}
+	tflog.Warn(ctx, "Setting the attribute to null, the API value cannot be converted", map[string]any{
+		"path":    convErr.Path.String(),
+		"pointer": convErr.Pointer,
+		"error":   convErr.Err.Error(),
+	})
+	vp.warn(convErr)
+	return nullVal, nil
+}
+
+// Returns the API value of the given attr.Value at p, with the attribute names converted
+// to their API names and the keys of maps to camelCase. If keepKeys is true, the keys of
+// maps are kept as is.
+func (c *Converter) fromValue(ctx context.Context, vp valuePath, attrValIf attr.Value, keepKeys bool) (_ any, err error) {
+	defer func() { err = wrapConversionError(vp, err) }()
 	if attrValIf == nil {
 		return nil, errors.New("value is nil")
 	}
@@ -604,7 +696,8 @@ func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any,
 	case basetypes.BoolValue:
 		return attrVal.ValueBool(), nil
 	case basetypes.DynamicValue:
-		return fromValue(ctx, attrVal.UnderlyingValue(), visitId)
+		// Dynamic values are free-form JSON, their keys are not attribute names
+		return DynamicToAny(ctx, attrVal)
 	case basetypes.Float32Value:
 		return attrVal.ValueFloat32(), nil
 	case basetypes.Float64Value:
@@ -615,11 +708,11 @@ func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any,
 		return attrVal.ValueInt64(), nil
 	case basetypes.ListValue:
 		value := []any{}
-		for _, v := range attrVal.Elements() {
+		for i, v := range attrVal.Elements() {
 			if v.IsNull() || v.IsUnknown() {
 				continue
 			}
-			val, err := fromValue(ctx, v, visitId)
+			val, err := c.fromValue(ctx, vp.atListIndex(i, len(value)), v, keepKeys)
 			if err != nil {
 				return nil, err
 			}
@@ -628,80 +721,54 @@ func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any,
 		return value, nil
 	case basetypes.MapValue:
 		value := make(map[string]any)
-		oldVisitId := visitId
 		for k, v := range attrVal.Elements() {
 			tflog.Trace(ctx, "fromValue()::Processing map elements",
-				map[string]any{"name": k, "visitId": visitId})
+				map[string]any{"name": k, "keepKeys": keepKeys})
 			if v.IsNull() || v.IsUnknown() {
 				continue
 			}
-			// If not already ignoring case, and we encounter a new attribute
-			// for which we need to ignore case, generate a new visitId, and
-			// start ignoring case for further visits.
-			if !getVisited(visitId) && ignoreCaseNames[k] {
-				visitId = newVisitID(k)
-				setVisited(visitId, true)
+			// The keys inside attributes for which we need to ignore case,
+			// such as labels, are kept as is.
+			elemKeepKeys := keepKeys || c.rules.IgnoreCaseNames[k]
+			key := k
+			if !elemKeepKeys {
+				key = c.SnakeToCamel(k)
 			}
-			val, err := fromValue(ctx, v, visitId)
+			val, err := c.fromValue(ctx, vp.atMapKey(k, key), v, elemKeepKeys)
 			if err != nil {
 				return nil, err
 			}
-			if getVisited(visitId) {
-				value[k] = val
-			} else {
-				value[SnakeToCamel(k)] = val
-			}
-			if visitId != oldVisitId {
-				tflog.Trace(ctx, "fromValue()::Deleting visitId in MapValue case",
-					map[string]any{"name": k, "oldVisitId": oldVisitId, "newVisitId": visitId})
-				clearVisited(visitId)
-				visitId = oldVisitId
-			}
+			value[key] = val
 		}
 		tflog.Trace(ctx, "fromValue()::Returning map from MapValue case",
-			map[string]any{"values": spew.Sdump(value), "visitId": visitId})
+			map[string]any{"values": spew.Sdump(value)})
 		return value, nil
 	case basetypes.NumberValue:
-		if attrVal.ValueBigFloat().IsInt() {
-			intVal, _ := attrVal.ValueBigFloat().Int64()
-			return intVal, nil
-		}
-		floatVal, _ := attrVal.ValueBigFloat().Float64()
-		return floatVal, nil
+		return bigFloatToAny(attrVal.ValueBigFloat()), nil
 	case basetypes.ObjectValue:
 		value := make(map[string]any)
-		oldVisitId := visitId
+		names := c.jsonNames(vp.attr, slices.Collect(maps.Keys(attrVal.Attributes())))
 		for k, v := range attrVal.Attributes() {
 			tflog.Trace(ctx, "fromValue()::Processing ObjectValue attributes",
-				map[string]any{"attrName": k, "visitId": visitId})
+				map[string]any{"attrName": k, "keepKeys": keepKeys})
 			if v.IsNull() || v.IsUnknown() {
 				continue
 			}
-			// If not already ignoring case, and we encounter a new attribute
-			// for which we need to ignore case, generate a new visitId, and
-			// start ignoring case for further visits.
-			if !getVisited(visitId) && ignoreCaseNames[k] {
-				visitId = newVisitID(k)
-				setVisited(visitId, true)
+			// The keys inside attributes for which we need to ignore case,
+			// such as labels, are kept as is.
+			elemKeepKeys := keepKeys || c.rules.IgnoreCaseNames[k]
+			key := names[k]
+			if elemKeepKeys {
+				key = k
 			}
-			val, err := fromValue(ctx, v, visitId)
+			val, err := c.fromValue(ctx, vp.atName(k, key), v, elemKeepKeys)
 			if err != nil {
 				return nil, err
 			}
-			if getVisited(visitId) {
-				value[k] = val
-			} else {
-				value[SnakeToCamel(k)] = val
-			}
-			if visitId != oldVisitId {
-				tflog.Trace(ctx, "fromValue()::Deleting visitId in ObjectValue case",
-					map[string]any{"attrName": k, "oldVisitId": oldVisitId, "newVisitId": visitId})
-				clearVisited(visitId)
-				visitId = oldVisitId
-			}
+			value[key] = val
 		}
 		tflog.Trace(ctx, "fromValue()::Returning map from ObjectValue case",
-			map[string]any{"values": spew.Sdump(value), "visitId": visitId})
+			map[string]any{"values": spew.Sdump(value)})
 		return value, nil
 	case basetypes.SetValue:
 		value := []any{}
@@ -709,7 +776,7 @@ func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any,
 			if v.IsNull() || v.IsUnknown() {
 				continue
 			}
-			val, err := fromValue(ctx, v, visitId)
+			val, err := c.fromValue(ctx, vp.atSetElement(v, len(value)), v, keepKeys)
 			if err != nil {
 				return nil, err
 			}
@@ -720,11 +787,11 @@ func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any,
 		return attrVal.ValueString(), nil
 	case basetypes.TupleValue:
 		value := []any{}
-		for _, v := range attrVal.Elements() {
+		for i, v := range attrVal.Elements() {
 			if v.IsNull() || v.IsUnknown() {
 				continue
 			}
-			val, err := fromValue(ctx, v, visitId)
+			val, err := c.fromValue(ctx, vp.atTupleIndex(i, len(value)), v, keepKeys)
 			if err != nil {
 				return nil, err
 			}
@@ -736,7 +803,7 @@ func fromValue(ctx context.Context, attrValIf attr.Value, visitId string) (any,
 		if d.HasError() {
 			return nil, fmt.Errorf("failed to get obj value: %v", d)
 		}
-		return fromValue(ctx, obj, visitId)
+		return c.fromValue(ctx, vp, obj, keepKeys)
 	default:
 		return nil, fmt.Errorf("unsupported type %s", attrValIf.Type(ctx).String())
 	}
@@ -887,20 +954,15 @@ func StringValue(attrValIf attr.Value) string {
 	case basetypes.DynamicValue:
 		return StringValue(attrVal.UnderlyingValue())
 	case basetypes.Float32Value:
-		return fmt.Sprintf("%f", attrVal.ValueFloat32())
+		return strconv.FormatFloat(float64(attrVal.ValueFloat32()), 'f', -1, 32)
 	case basetypes.Float64Value:
-		return fmt.Sprintf("%f", attrVal.ValueFloat64())
+		return strconv.FormatFloat(attrVal.ValueFloat64(), 'f', -1, 64)
 	case basetypes.Int32Value:
 		return fmt.Sprintf("%d", attrVal.ValueInt32())
 	case basetypes.Int64Value:
 		return fmt.Sprintf("%d", attrVal.ValueInt64())
 	case basetypes.NumberValue:
-		if attrVal.ValueBigFloat().IsInt() {
-			intVal, _ := attrVal.ValueBigFloat().Int64()
-			return fmt.Sprintf("%d", intVal)
-		}
-		floatVal, _ := attrVal.ValueBigFloat().Float64()
-		return fmt.Sprintf("%f", floatVal)
+		return attrVal.ValueBigFloat().Text('g', -1)
 	case basetypes.StringValue:
 		return attrVal.ValueString()
 	default:
@@ -908,7 +970,13 @@ func StringValue(attrValIf attr.Value) string {
 	}
 }
 
+// ModelToStringMap uses the default naming rules, see Converter.ModelToStringMap.
 func ModelToStringMap(ctx context.Context, model any) (map[string]string, error) {
+	return defaultConverter.ModelToStringMap(ctx, model)
+}
+
+// ModelToStringMap returns the scalar attributes of a Terraform model as strings, by API name.
+func (c *Converter) ModelToStringMap(ctx context.Context, model any) (map[string]string, error) {
 	body := map[string]string{}
 	typ := reflect.TypeOf(model)
 	val := reflect.ValueOf(model)
@@ -923,6 +991,7 @@ func ModelToStringMap(ctx context.Context, model any) (map[string]string, error)
 	}
 
 	attrValIf := reflect.TypeOf((*attr.Value)(nil)).Elem()
+	names := c.modelJSONNames(typ.Elem())
 	for i := range typ.Elem().NumField() {
 		field := typ.Elem().Field(i)
 		// Check if the model struct field implements attr.Value
@@ -931,8 +1000,8 @@ func ModelToStringMap(ctx context.Context, model any) (map[string]string, error)
 				typ.Elem().String(), field.Name))
 			continue
 		}
-		// Convert the field name from its `tfsdk` tag to camelCase
-		fieldName := SnakeToCamel(field.Tag.Get("tfsdk"))
+		// Convert the field name from its `tfsdk` tag to its API name
+		fieldName := names[field.Tag.Get("tfsdk")]
 		attrVal := val.Elem().Field(i).Interface().(attr.Value)
 
 		tflog.Debug(ctx, "ModelToStringMap()::Iterating over fields", map[string]any{
@@ -943,18 +1012,33 @@ func ModelToStringMap(ctx context.Context, model any) (map[string]string, error)
 			"attrVal":   attrVal.String(),
 		})
 
-		// If the attr.Value is not null and not unknown, and is a string type, use it to build the map
-		if !attrVal.IsNull() &&
-			!attrVal.IsUnknown() &&
-			(attrVal.Type(ctx).Equal(types.StringType) || attrVal.Type(ctx).Equal(types.BoolType)) {
-			// Convert the attr.Value to a Go string type
-			body[fieldName] = StringValue(attrVal)
+		if attrVal.IsNull() || attrVal.IsUnknown() {
+			continue
 		}
+		// Scalars are used as is, lists and sets of scalars are joined with commas
+		param := QueryParam{Name: fieldName}
+		switch attrVal.(type) {
+		case basetypes.ListValue, basetypes.SetValue:
+			param.Type = "array"
+		}
+		values, err := queryParamValues(ctx, param, attrVal)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("ModelToStringMap()::%s.%s is not a query parameter: %v",
+				typ.Elem().String(), field.Name, err))
+			continue
+		}
+		body[fieldName] = values[0]
 	}
 	return body, nil
 }
 
+// ModelToAnyMap uses the default naming rules, see Converter.ModelToAnyMap.
 func ModelToAnyMap(ctx context.Context, model any) (map[string]any, error) {
+	return defaultConverter.ModelToAnyMap(ctx, model)
+}
+
+// ModelToAnyMap converts the attributes of a Terraform model to an API request body.
+func (c *Converter) ModelToAnyMap(ctx context.Context, model any) (map[string]any, error) {
 	body := map[string]any{}
 	typ := reflect.TypeOf(model)
 	val := reflect.ValueOf(model)
@@ -969,6 +1053,7 @@ func ModelToAnyMap(ctx context.Context, model any) (map[string]any, error) {
 	}
 
 	attrValIf := reflect.TypeOf((*attr.Value)(nil)).Elem()
+	names := c.modelJSONNames(typ.Elem())
 	for i := range typ.Elem().NumField() {
 		field := typ.Elem().Field(i)
 		// Check if the model struct field implements attr.Value
@@ -977,8 +1062,9 @@ func ModelToAnyMap(ctx context.Context, model any) (map[string]any, error) {
 				typ.Elem().String(), field.Name))
 			continue
 		}
-		// Convert the field name from its `tfsdk` tag to camelCase
-		fieldName := SnakeToCamel(field.Tag.Get("tfsdk"))
+		// Convert the field name from its `tfsdk` tag to its API name
+		fieldName := names[field.Tag.Get("tfsdk")]
+		fieldPath := valuePath{}.atName(field.Tag.Get("tfsdk"), fieldName)
 		attrVal := val.Elem().Field(i).Interface().(attr.Value)
 
 		tflog.Debug(ctx, "ModelToAnyMap()::Iterating over fields", map[string]any{
@@ -992,7 +1078,7 @@ func ModelToAnyMap(ctx context.Context, model any) (map[string]any, error) {
 		// If the attr.Value is not null and not unknown, use it to build the request
 		if !attrVal.IsNull() && !attrVal.IsUnknown() {
 			// Convert the attr.Value to an appropriate Go type
-			anyVal, err := fromValue(ctx, attrVal, "")
+			anyVal, err := c.fromValue(ctx, fieldPath, attrVal, false)
 			if err != nil {
 				return nil, err
 			}
@@ -1002,7 +1088,15 @@ func ModelToAnyMap(ctx context.Context, model any) (map[string]any, error) {
 	return body, nil
 }
 
+// AnyMapToModel uses the default naming rules, see Converter.AnyMapToModel.
 func AnyMapToModel(ctx context.Context, resp map[string]any, model any) error {
+	return defaultConverter.AnyMapToModel(ctx, resp, model)
+}
+
+// AnyMapToModel sets the attributes of a Terraform model from an API response. The keys of
+// the response without an attribute are set to the extra attribute, if the model has one.
+// A lenient converter returns ConversionWarnings when some attributes were set to null.
+func (c *Converter) AnyMapToModel(ctx context.Context, resp map[string]any, model any) error {
 	modelType := reflect.TypeOf(model)
 	modelValue := reflect.ValueOf(model)
 	tflog.Debug(ctx, "AnyMapToModel()", map[string]any{
@@ -1016,6 +1110,8 @@ func AnyMapToModel(ctx context.Context, resp map[string]any, model any) error {
 	}
 
 	attrValIf := reflect.TypeOf((*attr.Value)(nil)).Elem()
+	names := c.modelJSONNames(modelType.Elem())
+	modelPath := valuePath{warnings: &[]*ConversionError{}}
 	for i := range modelType.Elem().NumField() {
 		field := modelType.Elem().Field(i)
 		// Check if the model struct field implements attr.Value
@@ -1024,9 +1120,11 @@ func AnyMapToModel(ctx context.Context, resp map[string]any, model any) error {
 				modelType.Elem().String(), field.Name))
 			continue
 		}
-		// Convert the field name from its `tfsdk` tag to camelCase
-		fieldName := SnakeToCamel(field.Tag.Get("tfsdk"))
+		// Convert the field name from its `tfsdk` tag to its API name
+		fieldName := names[field.Tag.Get("tfsdk")]
+		fieldPath := modelPath.atName(field.Tag.Get("tfsdk"), fieldName)
 		attrVal := modelValue.Elem().Field(i).Interface().(attr.Value)
+		respVal, _ := jsonValue(resp, names, field.Tag.Get("tfsdk"), attrVal.Type(ctx))
 
 		tflog.Debug(ctx, "AnyMapToModel()::Iterating over fields", map[string]any{
 			"fieldName": fieldName,
@@ -1036,12 +1134,158 @@ func AnyMapToModel(ctx context.Context, resp map[string]any, model any) error {
 			"attrVal":   attrVal.String(),
 		})
 
-		newVal, err := newValue(ctx, attrVal.Type(ctx), resp[fieldName], "")
+		newVal, err := c.newValue(ctx, fieldPath, attrVal.Type(ctx), respVal, false)
 		if err != nil {
 			return err
 		}
 		// Set the new value to the model field
 		modelValue.Elem().Field(i).Set(reflect.ValueOf(newVal))
 	}
-	return nil
+	return modelPath.warningsErr()
+}
+
+// DynamicToAny converts a dynamic value to plain Go values, as they would be decoded from JSON.
+// Unlike the other attributes of ModelToAnyMap, object and map keys are kept verbatim, which suits
+// values that are already written in API form, such as the spec of a CR. Null and unknown
+// attributes are dropped, null elements of lists and tuples are kept as nil.
+func DynamicToAny(ctx context.Context, attrValIf attr.Value) (any, error) {
+	if attrValIf == nil || attrValIf.IsNull() || attrValIf.IsUnknown() {
+		return nil, nil
+	}
+	switch attrVal := attrValIf.(type) {
+	case basetypes.DynamicValue:
+		return DynamicToAny(ctx, attrVal.UnderlyingValue())
+	case basetypes.BoolValue:
+		return attrVal.ValueBool(), nil
+	case basetypes.StringValue:
+		return attrVal.ValueString(), nil
+	case basetypes.Int32Value:
+		return int64(attrVal.ValueInt32()), nil
+	case basetypes.Int64Value:
+		return attrVal.ValueInt64(), nil
+	case basetypes.Float32Value:
+		return float64(attrVal.ValueFloat32()), nil
+	case basetypes.Float64Value:
+		return attrVal.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		return bigFloatToAny(attrVal.ValueBigFloat()), nil
+	case basetypes.ListValue:
+		return dynamicElementsToAny(ctx, attrVal.Elements())
+	case basetypes.SetValue:
+		return dynamicElementsToAny(ctx, attrVal.Elements())
+	case basetypes.TupleValue:
+		return dynamicElementsToAny(ctx, attrVal.Elements())
+	case basetypes.MapValue:
+		return dynamicAttributesToAny(ctx, attrVal.Elements())
+	case basetypes.ObjectValue:
+		return dynamicAttributesToAny(ctx, attrVal.Attributes())
+	case basetypes.ObjectValuable:
+		obj, d := attrVal.ToObjectValue(ctx)
+		if d.HasError() {
+			return nil, fmt.Errorf("failed to get obj value: %v", d)
+		}
+		return DynamicToAny(ctx, obj)
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", attrValIf)
+	}
+}
+
+func dynamicElementsToAny(ctx context.Context, elems []attr.Value) ([]any, error) {
+	value := []any{}
+	for _, v := range elems {
+		// Null elements are kept, so that the other elements keep their position
+		if v.IsUnknown() {
+			continue
+		}
+		val, err := DynamicToAny(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		value = append(value, val)
+	}
+	return value, nil
+}
+
+func dynamicAttributesToAny(ctx context.Context, attrs map[string]attr.Value) (map[string]any, error) {
+	value := map[string]any{}
+	for k, v := range attrs {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		val, err := DynamicToAny(ctx, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		value[k] = val
+	}
+	return value, nil
+}
+
+// AnyToDynamic converts plain Go values, as decoded from JSON, to a dynamic value.
+// Arrays become tuples and objects become objects, the same types HCL gives to literals,
+// so that the result compares equal to a configuration written in the same shape.
+func AnyToDynamic(val any) (types.Dynamic, error) {
+	if val == nil {
+		return types.DynamicNull(), nil
+	}
+	attrVal, err := anyToValue(val)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(attrVal), nil
+}
+
+func anyToValue(val any) (attr.Value, error) {
+	switch v := val.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case json.Number, *big.Float, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		f, err := NumToBigFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(f), nil
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, e := range v {
+			elem, err := anyToValue(e)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		tupleVal, d := types.TupleValue(elemTypes, elems)
+		if d.HasError() {
+			return nil, fmt.Errorf("failed to build tuple value: %v", d)
+		}
+		return tupleVal, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			// Null attributes are omitted, as they are when converting the other way
+			if e == nil {
+				continue
+			}
+			elem, err := anyToValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k] = elem.Type(context.Background())
+			attrs[k] = elem
+		}
+		objVal, d := types.ObjectValue(attrTypes, attrs)
+		if d.HasError() {
+			return nil, fmt.Errorf("failed to build object value: %v", d)
+		}
+		return objVal, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", val)
+	}
 }
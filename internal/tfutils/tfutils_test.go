@@ -1,6 +1,22 @@
 package tfutils
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/url"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 func TestSnakeToCamel(t *testing.T) {
 	tests := []struct {
@@ -122,3 +138,887 @@ func TestCamelToSnake(t *testing.T) {
 		})
 	}
 }
+
+func TestAnyToDynamicRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected any
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "scalars",
+			input:    map[string]any{"enabled": true, "description": "uplink", "mtu": float64(9000), "ratio": 0.5},
+			expected: map[string]any{"enabled": true, "description": "uplink", "mtu": int64(9000), "ratio": 0.5},
+		},
+		{
+			name:     "keys are kept verbatim",
+			input:    map[string]any{"lacpPortPriority": float64(32768), "vlan_id": "10"},
+			expected: map[string]any{"lacpPortPriority": int64(32768), "vlan_id": "10"},
+		},
+		{
+			name: "nested arrays and objects",
+			input: map[string]any{"members": []any{
+				map[string]any{"node": "leaf-1", "interface": "ethernet-1-1"},
+				"mixed",
+			}},
+			expected: map[string]any{"members": []any{
+				map[string]any{"node": "leaf-1", "interface": "ethernet-1-1"},
+				"mixed",
+			}},
+		},
+		{
+			name:     "null attributes are dropped",
+			input:    map[string]any{"name": "a", "description": nil},
+			expected: map[string]any{"name": "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dynVal, err := AnyToDynamic(tt.input)
+			if err != nil {
+				t.Fatalf("AnyToDynamic(%v) returned error: %v", tt.input, err)
+			}
+			result, err := DynamicToAny(context.Background(), dynVal)
+			if err != nil {
+				t.Fatalf("DynamicToAny(%v) returned error: %v", dynVal, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("DynamicToAny(AnyToDynamic(%v)) = %#v, want %#v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestListOptionsApply(t *testing.T) {
+	items := []any{
+		map[string]any{"name": "a", "severity": "major", "occurrences": float64(10), "resource": map[string]any{"nodeName": "leaf-1"}},
+		map[string]any{"name": "b", "severity": "critical", "occurrences": float64(2), "resource": map[string]any{"nodeName": "leaf-2"}},
+		map[string]any{"name": "c", "severity": "critical", "occurrences": float64(30), "resource": map[string]any{"nodeName": "leaf-1"}},
+		map[string]any{"name": "d", "severity": "minor"},
+	}
+	strList := func(s ...string) types.List {
+		elems := []attr.Value{}
+		for _, e := range s {
+			elems = append(elems, types.StringValue(e))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	strMap := func(m map[string]string) types.Map {
+		elems := map[string]attr.Value{}
+		for k, v := range m {
+			elems[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elems)
+	}
+	names := func(items []any) []string {
+		out := []string{}
+		for _, item := range items {
+			out = append(out, item.(map[string]any)["name"].(string))
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		options  ListOptionsModel
+		expected []string
+	}{
+		{
+			name:     "no options",
+			options:  ListOptionsModel{},
+			expected: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:     "match",
+			options:  ListOptionsModel{Match: strMap(map[string]string{"severity": "critical"})},
+			expected: []string{"b", "c"},
+		},
+		{
+			name:     "match nested attribute by snake_case name",
+			options:  ListOptionsModel{Match: strMap(map[string]string{"resource.node_name": "leaf-1"})},
+			expected: []string{"a", "c"},
+		},
+		{
+			name:     "sort numerically, missing values last",
+			options:  ListOptionsModel{SortBy: types.StringValue("occurrences")},
+			expected: []string{"b", "a", "c", "d"},
+		},
+		{
+			name:     "sort descending with offset and limit",
+			options:  ListOptionsModel{SortBy: types.StringValue("occurrences"), SortDescending: types.BoolValue(true), Offset: types.Int64Value(1), Limit: types.Int64Value(2)},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "offset past the end",
+			options:  ListOptionsModel{Offset: types.Int64Value(10)},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.options.Apply(context.Background(), defaultConverter, "", items)
+			if err != nil {
+				t.Fatalf("Apply() returned error: %v", err)
+			}
+			if got := names(result); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Apply() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("attributes", func(t *testing.T) {
+		result, err := ListOptionsModel{Attributes: strList("name", "node_name"), Limit: types.Int64Value(1)}.Apply(context.Background(), defaultConverter, "", items)
+		if err != nil {
+			t.Fatalf("Apply() returned error: %v", err)
+		}
+		expected := []any{map[string]any{"name": "a"}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Apply() = %v, want %v", result, expected)
+		}
+	})
+
+	// The names are converted with the JSON names of the converter, under listAttr,
+	// which the naming rules alone would get wrong
+	rules := DefaultNamingRules()
+	rules.JSONNames = map[string]string{
+		"results.bundledtransactionid": "bundledTransactionId",
+		"results.lag.lacpportpriority": "lacp-port-priority",
+		"results.lag":                  "lag",
+		"results.name":                 "name",
+	}
+	c := NewConverter(rules)
+	specItems := []any{
+		map[string]any{"name": "a", "bundledTransactionId": float64(7), "lag": map[string]any{"lacp-port-priority": float64(100)}},
+		map[string]any{"name": "b", "bundledTransactionId": float64(3), "lag": map[string]any{"lacp-port-priority": float64(200)}},
+		map[string]any{"name": "c", "bundledTransactionId": float64(5)},
+	}
+
+	jsonNameTests := []struct {
+		name     string
+		options  ListOptionsModel
+		expected []string
+	}{
+		{
+			name:     "match by JSON name",
+			options:  ListOptionsModel{Match: strMap(map[string]string{"bundled_transaction_id": "3"})},
+			expected: []string{"b"},
+		},
+		{
+			name:     "match nested attribute by JSON name",
+			options:  ListOptionsModel{Match: strMap(map[string]string{"lag.lacp_port_priority": "100"})},
+			expected: []string{"a"},
+		},
+		{
+			name:     "sort by JSON name",
+			options:  ListOptionsModel{SortBy: types.StringValue("bundled_transaction_id")},
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			name:     "API name as is",
+			options:  ListOptionsModel{SortBy: types.StringValue("bundledTransactionId"), SortDescending: types.BoolValue(true)},
+			expected: []string{"a", "c", "b"},
+		},
+	}
+
+	for _, tt := range jsonNameTests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.options.Apply(context.Background(), c, "results", specItems)
+			if err != nil {
+				t.Fatalf("Apply() returned error: %v", err)
+			}
+			if got := names(result); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Apply() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("attributes by JSON name", func(t *testing.T) {
+		result, err := ListOptionsModel{Attributes: strList("name", "bundled_transaction_id"), Limit: types.Int64Value(1)}.Apply(context.Background(), c, "results", specItems)
+		if err != nil {
+			t.Fatalf("Apply() returned error: %v", err)
+		}
+		expected := []any{map[string]any{"name": "a", "bundledTransactionId": float64(7)}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Apply() = %v, want %v", result, expected)
+		}
+	})
+}
+
+func TestModelToQueryValues(t *testing.T) {
+	type queryModel struct {
+		Count     types.Int64   `tfsdk:"count"`
+		FullRoles types.Bool    `tfsdk:"full_roles"`
+		Fullroles types.Bool    `tfsdk:"fullroles"`
+		Keys      types.List    `tfsdk:"keys"`
+		Label     types.String  `tfsdk:"label_selector"`
+		Ratio     types.Float64 `tfsdk:"ratio"`
+		Size      types.Number  `tfsdk:"size"`
+		Unused    types.String  `tfsdk:"unused"`
+	}
+	keys := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})
+
+	tests := []struct {
+		name     string
+		model    queryModel
+		params   []QueryParam
+		expected url.Values
+		wantErr  bool
+	}{
+		{
+			name: "scalars",
+			model: queryModel{
+				Count: types.Int64Value(42), Label: types.StringValue("app=x"), Size: types.NumberValue(big.NewFloat(7)),
+				FullRoles: types.BoolValue(true), Unused: types.StringValue("x"),
+			},
+			params: []QueryParam{
+				{Name: "count", Type: "integer", Style: "form", Explode: true},
+				{Name: "full-roles", Type: "boolean", Style: "form", Explode: true},
+				{Name: "fullRoles", Type: "boolean", Style: "form", Explode: true},
+				{Name: "label-selector", Type: "string", Style: "form", Explode: true},
+				{Name: "size", Type: "integer", Style: "form", Explode: true},
+			},
+			expected: url.Values{"count": {"42"}, "full-roles": {"true"}, "label-selector": {"app=x"}, "size": {"7"}},
+		},
+		{
+			name:     "null values are not sent",
+			model:    queryModel{Count: types.Int64Null(), Label: types.StringUnknown()},
+			params:   []QueryParam{{Name: "count", Type: "integer"}, {Name: "labelSelector", Type: "string"}},
+			expected: url.Values{},
+		},
+		{
+			name:     "exploded array",
+			model:    queryModel{Keys: keys},
+			params:   []QueryParam{{Name: "keys", Type: "array", Style: "form", Explode: true}},
+			expected: url.Values{"keys": {"a", "b"}},
+		},
+		{
+			name:     "comma joined array",
+			model:    queryModel{Keys: keys},
+			params:   []QueryParam{{Name: "keys", Type: "array", Style: "form", Explode: false}},
+			expected: url.Values{"keys": {"a,b"}},
+		},
+		{
+			name:     "pipe delimited array",
+			model:    queryModel{Keys: keys},
+			params:   []QueryParam{{Name: "keys", Type: "array", Style: "pipeDelimited"}},
+			expected: url.Values{"keys": {"a|b"}},
+		},
+		{
+			name:     "scalar as array of one item",
+			model:    queryModel{Count: types.Int64Value(3)},
+			params:   []QueryParam{{Name: "count", Type: "array", Style: "form", Explode: true}},
+			expected: url.Values{"count": {"3"}},
+		},
+		{
+			name:    "float for integer parameter",
+			model:   queryModel{Ratio: types.Float64Value(0.5)},
+			params:  []QueryParam{{Name: "ratio", Type: "integer"}},
+			wantErr: true,
+		},
+		{
+			name:    "list for scalar parameter",
+			model:   queryModel{Keys: keys},
+			params:  []QueryParam{{Name: "keys", Type: "string"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ModelToQueryValues(context.Background(), &tt.model, tt.params)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ModelToQueryValues() = %v, want error", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ModelToQueryValues() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ModelToQueryValues() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnyMapToModelDynamicRoundTrip(t *testing.T) {
+	type crModel struct {
+		Name   types.String  `tfsdk:"name"`
+		Spec   types.Dynamic `tfsdk:"spec"`
+		Status types.Dynamic `tfsdk:"status"`
+	}
+
+	tests := []struct {
+		name     string
+		input    map[string]any
+		expected map[string]any
+	}{
+		{
+			name:     "missing dynamic values are null",
+			input:    map[string]any{"name": "a"},
+			expected: map[string]any{"name": "a"},
+		},
+		{
+			name: "objects keep their keys verbatim",
+			input: map[string]any{"name": "a", "spec": map[string]any{
+				"lacpPortPriority": float64(32768), "vlan_id": "10", "enabled": true, "ratio": 0.25,
+			}},
+			expected: map[string]any{"name": "a", "spec": map[string]any{
+				"lacpPortPriority": int64(32768), "vlan_id": "10", "enabled": true, "ratio": 0.25,
+			}},
+		},
+		{
+			name: "arrays become tuples and keep null elements",
+			input: map[string]any{"name": "a", "spec": map[string]any{
+				"members": []any{map[string]any{"node": "leaf-1"}, "mixed", nil, float64(1)},
+			}},
+			expected: map[string]any{"name": "a", "spec": map[string]any{
+				"members": []any{map[string]any{"node": "leaf-1"}, "mixed", nil, int64(1)},
+			}},
+		},
+		{
+			name:     "null object attributes are dropped",
+			input:    map[string]any{"name": "a", "status": map[string]any{"health": float64(98), "reason": nil}},
+			expected: map[string]any{"name": "a", "status": map[string]any{"health": int64(98)}},
+		},
+		{
+			name:     "scalar dynamic value",
+			input:    map[string]any{"name": "a", "status": "Ready"},
+			expected: map[string]any{"name": "a", "status": "Ready"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := crModel{}
+			if err := AnyMapToModel(context.Background(), tt.input, &model); err != nil {
+				t.Fatalf("AnyMapToModel(%v) returned error: %v", tt.input, err)
+			}
+			if _, found := tt.input["spec"]; !found && !model.Spec.IsNull() {
+				t.Errorf("AnyMapToModel() spec = %v, want null", model.Spec)
+			}
+			result, err := ModelToAnyMap(context.Background(), &model)
+			if err != nil {
+				t.Fatalf("ModelToAnyMap(%v) returned error: %v", model, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ModelToAnyMap(AnyMapToModel(%v)) = %#v, want %#v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMergeAnyMapToModel(t *testing.T) {
+	type userModel struct {
+		Auth     types.Object `tfsdk:"auth"`
+		Enabled  types.Bool   `tfsdk:"enabled"`
+		Name     types.String `tfsdk:"name"`
+		Password types.String `tfsdk:"password" tfutils:"write_only"`
+		Status   types.String `tfsdk:"status"`
+		Uuid     types.String `tfsdk:"uuid"`
+	}
+	authTypes := map[string]attr.Type{"bind_credential": types.StringType, "bind_dn": types.StringType}
+	auth := func(credential, dn string) types.Object {
+		return types.ObjectValueMust(authTypes, map[string]attr.Value{
+			"bind_credential": types.StringValue(credential),
+			"bind_dn":         types.StringValue(dn),
+		})
+	}
+	planned := func() userModel {
+		return userModel{
+			Auth:     auth("secret", "cn=admin"),
+			Enabled:  types.BoolValue(true),
+			Name:     types.StringValue("alice"),
+			Password: types.StringValue("s3cret"),
+			Status:   types.StringValue("active"),
+			Uuid:     types.StringUnknown(),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		resp     map[string]any
+		modes    FieldModes
+		expected userModel
+	}{
+		{
+			name: "omitted attributes keep their value, unknown ones become null",
+			resp: map[string]any{"name": "alice", "enabled": false},
+			expected: userModel{
+				Auth: auth("secret", "cn=admin"), Enabled: types.BoolValue(false), Name: types.StringValue("alice"),
+				Password: types.StringValue("s3cret"), Status: types.StringValue("active"), Uuid: types.StringNull(),
+			},
+		},
+		{
+			name: "write-only attributes ignore the response",
+			resp: map[string]any{"password": "", "uuid": "1234"},
+			expected: userModel{
+				Auth: auth("secret", "cn=admin"), Enabled: types.BoolValue(true), Name: types.StringValue("alice"),
+				Password: types.StringValue("s3cret"), Status: types.StringValue("active"), Uuid: types.StringValue("1234"),
+			},
+		},
+		{
+			name:  "server owned attributes are null when omitted",
+			resp:  map[string]any{"uuid": "1234"},
+			modes: FieldModes{"status": FieldServerOwned},
+			expected: userModel{
+				Auth: auth("secret", "cn=admin"), Enabled: types.BoolValue(true), Name: types.StringValue("alice"),
+				Password: types.StringValue("s3cret"), Status: types.StringNull(), Uuid: types.StringValue("1234"),
+			},
+		},
+		{
+			name:  "nested objects are merged",
+			resp:  map[string]any{"auth": map[string]any{"bindDN": "cn=root", "bindCredential": "*****"}},
+			modes: FieldModes{"auth.bind_credential": FieldWriteOnly},
+			expected: userModel{
+				Auth: auth("secret", "cn=root"), Enabled: types.BoolValue(true), Name: types.StringValue("alice"),
+				Password: types.StringValue("s3cret"), Status: types.StringValue("active"), Uuid: types.StringNull(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := planned()
+			if err := MergeAnyMapToModel(context.Background(), tt.resp, &model, tt.modes); err != nil {
+				t.Fatalf("MergeAnyMapToModel(%v) returned error: %v", tt.resp, err)
+			}
+			if !reflect.DeepEqual(model, tt.expected) {
+				t.Errorf("MergeAnyMapToModel(%v) = %v, want %v", tt.resp, model, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumericConversions(t *testing.T) {
+	ctx := context.Background()
+	bigNumber, _, _ := big.ParseFloat("123456789012345678901234567890", 10, 512, big.ToNearestEven)
+
+	tests := []struct {
+		name     string
+		attrType attr.Type
+		input    any
+		expected attr.Value
+		wantErr  bool
+	}{
+		{name: "int64 max", attrType: types.Int64Type, input: json.Number("9223372036854775807"), expected: types.Int64Value(9223372036854775807)},
+		{name: "int64 min", attrType: types.Int64Type, input: json.Number("-9223372036854775808"), expected: types.Int64Value(-9223372036854775808)},
+		{name: "int64 above 2^53", attrType: types.Int64Type, input: json.Number("9007199254740993"), expected: types.Int64Value(9007199254740993)},
+		{name: "int64 overflow", attrType: types.Int64Type, input: json.Number("9223372036854775808"), wantErr: true},
+		{name: "int64 fraction", attrType: types.Int64Type, input: json.Number("1.5"), wantErr: true},
+		{name: "int64 exponent", attrType: types.Int64Type, input: json.Number("1e3"), expected: types.Int64Value(1000)},
+		{name: "int64 from float64", attrType: types.Int64Type, input: float64(4294967296), expected: types.Int64Value(4294967296)},
+		{name: "int32 max", attrType: types.Int32Type, input: json.Number("2147483647"), expected: types.Int32Value(2147483647)},
+		{name: "int32 min", attrType: types.Int32Type, input: json.Number("-2147483648"), expected: types.Int32Value(-2147483648)},
+		{name: "int32 overflow", attrType: types.Int32Type, input: json.Number("2147483648"), wantErr: true},
+		{name: "int32 from float64", attrType: types.Int32Type, input: float64(65000), expected: types.Int32Value(65000)},
+		{name: "float64", attrType: types.Float64Type, input: json.Number("0.1"), expected: types.Float64Value(0.1)},
+		{name: "float64 max", attrType: types.Float64Type, input: json.Number("1.7976931348623157e308"), expected: types.Float64Value(1.7976931348623157e308)},
+		{name: "float64 overflow", attrType: types.Float64Type, input: json.Number("1e309"), wantErr: true},
+		{name: "float32", attrType: types.Float32Type, input: json.Number("0.5"), expected: types.Float32Value(0.5)},
+		{name: "float32 overflow", attrType: types.Float32Type, input: json.Number("1e39"), wantErr: true},
+		{name: "number beyond int64", attrType: types.NumberType, input: json.Number("123456789012345678901234567890"), expected: types.NumberValue(bigNumber)},
+		{name: "not a number", attrType: types.Int64Type, input: "ten", wantErr: true},
+		{name: "bool is not a number", attrType: types.NumberType, input: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := defaultConverter.newValue(ctx, valuePath{}, tt.attrType, tt.input, false)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("newValue(%s, %v) = %v, want error", tt.attrType, tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newValue(%s, %v) returned error: %v", tt.attrType, tt.input, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("newValue(%s, %v) = %v, want %v", tt.attrType, tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("numbers round trip through JSON", func(t *testing.T) {
+		for _, in := range []string{"9007199254740993", "123456789012345678901234567890", "-42", "0.1", "1e-9", "3.141592653589793238462643383279"} {
+			val, err := defaultConverter.newValue(ctx, valuePath{}, types.NumberType, json.Number(in), false)
+			if err != nil {
+				t.Fatalf("newValue(%s) returned error: %v", in, err)
+			}
+			out, err := defaultConverter.fromValue(ctx, valuePath{}, val, false)
+			if err != nil {
+				t.Fatalf("fromValue(%v) returned error: %v", val, err)
+			}
+			raw, err := json.Marshal(out)
+			if err != nil {
+				t.Fatalf("json.Marshal(%v) returned error: %v", out, err)
+			}
+			want, _, _ := big.ParseFloat(in, 10, 512, big.ToNearestEven)
+			got, _, err := big.ParseFloat(string(raw), 10, 512, big.ToNearestEven)
+			if err != nil || got.Cmp(want) != 0 {
+				t.Errorf("%s round trips to %s", in, raw)
+			}
+		}
+	})
+}
+
+func TestConverterConcurrent(t *testing.T) {
+	type metadataModel struct {
+		Labels types.Map    `tfsdk:"labels"`
+		Tags   types.Map    `tfsdk:"tags"`
+		VlanId types.String `tfsdk:"vlan_id"`
+	}
+	type model struct {
+		Metadata types.Object `tfsdk:"metadata"`
+		VlanId   types.String `tfsdk:"vlan_id"`
+	}
+	metadataTypes := map[string]attr.Type{
+		"labels":  types.MapType{ElemType: types.StringType},
+		"tags":    types.MapType{ElemType: types.StringType},
+		"vlan_id": types.StringType,
+	}
+	newModel := func(tagKey string) model {
+		labels := types.MapValueMust(types.StringType, map[string]attr.Value{"app_name": types.StringValue("x")})
+		tags := types.MapValueMust(types.StringType, map[string]attr.Value{tagKey: types.StringValue("x")})
+		return model{
+			Metadata: types.ObjectValueMust(metadataTypes, map[string]attr.Value{
+				"labels": labels, "tags": tags, "vlan_id": types.StringValue("10"),
+			}),
+			VlanId: types.StringValue("20"),
+		}
+	}
+
+	rules := DefaultNamingRules()
+	rules.SnakeToCamelNames["vlan_id"] = "vlan-id"
+	rules.IgnoreCaseNames["tags"] = true
+	tests := []struct {
+		name      string
+		converter *Converter
+		expected  map[string]any
+		// The keys of tags are converted by the default rules, and read back in lower case
+		readTagKey string
+	}{
+		{
+			name:      "default rules",
+			converter: defaultConverter,
+			expected: map[string]any{
+				"metadata": map[string]any{
+					"labels": map[string]any{"app_name": "x"}, "tags": map[string]any{"appName": "x"}, "vlanID": "10",
+				},
+				"vlanID": "20",
+			},
+			readTagKey: "appname",
+		},
+		{
+			name:      "resource rules",
+			converter: NewConverter(rules),
+			expected: map[string]any{
+				"metadata": map[string]any{
+					"labels": map[string]any{"app_name": "x"}, "tags": map[string]any{"app_name": "x"}, "vlan-id": "10",
+				},
+				"vlan-id": "20",
+			},
+			readTagKey: "app_name",
+		},
+	}
+	if SnakeToCamel("vlan_id") != "vlanID" {
+		t.Fatalf("changing a copy of the default naming rules changed the default converter")
+	}
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for range 50 {
+		for _, tt := range tests {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				in := newModel("app_name")
+				body, err := tt.converter.ModelToAnyMap(ctx, &in)
+				if err != nil {
+					t.Errorf("%s: ModelToAnyMap returned error: %v", tt.name, err)
+					return
+				}
+				if !reflect.DeepEqual(body, tt.expected) {
+					t.Errorf("%s: ModelToAnyMap = %v, want %v", tt.name, body, tt.expected)
+					return
+				}
+				out := model{Metadata: types.ObjectNull(metadataTypes), VlanId: types.StringNull()}
+				if err := tt.converter.AnyMapToModel(ctx, body, &out); err != nil {
+					t.Errorf("%s: AnyMapToModel returned error: %v", tt.name, err)
+					return
+				}
+				if want := newModel(tt.readTagKey); !reflect.DeepEqual(out, want) {
+					t.Errorf("%s: AnyMapToModel(%v) = %v, want %v", tt.name, body, out, want)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func TestConverterJSONNames(t *testing.T) {
+	type model struct {
+		Auth      types.Object `tfsdk:"auth"`
+		FullRoles types.List   `tfsdk:"full_roles"`
+		Fullroles types.Bool   `tfsdk:"fullroles"`
+		VlanId    types.Int64  `tfsdk:"vlan_id"`
+	}
+	authTypes := map[string]attr.Type{"bind_dn": types.StringType, "group_ldap_dn": types.StringType}
+
+	rules := DefaultNamingRules()
+	rules.JSONNames = map[string]string{
+		"auth":             "auth",
+		"auth.binddn":      "bindDn",
+		"auth.groupldapdn": "groupLDAPDn",
+		"fullroles":        "fullRoles",
+		"vlanid":           "vlanId",
+	}
+	c := NewConverter(rules)
+	in := model{
+		Auth: types.ObjectValueMust(authTypes, map[string]attr.Value{
+			"bind_dn":       types.StringValue("cn=admin"),
+			"group_ldap_dn": types.StringValue("ou=groups"),
+		}),
+		FullRoles: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("admin")}),
+		Fullroles: types.BoolValue(true),
+		VlanId:    types.Int64Value(10),
+	}
+	expected := map[string]any{
+		"auth": map[string]any{"bindDn": "cn=admin", "groupLDAPDn": "ou=groups"},
+		// Attributes with the same key fall back to the naming heuristics
+		"fullRoles":  []any{"admin"},
+		"full-roles": true,
+		"vlanId":     int64(10),
+	}
+
+	ctx := context.Background()
+	body, err := c.ModelToAnyMap(ctx, &in)
+	if err != nil {
+		t.Fatalf("ModelToAnyMap returned error: %v", err)
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("ModelToAnyMap = %v, want %v", body, expected)
+	}
+	out := model{
+		Auth:      types.ObjectNull(authTypes),
+		FullRoles: types.ListNull(types.StringType),
+		Fullroles: types.BoolNull(),
+		VlanId:    types.Int64Null(),
+	}
+	if err := c.AnyMapToModel(ctx, body, &out); err != nil {
+		t.Fatalf("AnyMapToModel returned error: %v", err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("AnyMapToModel(%v) = %v, want %v", body, out, in)
+	}
+	if name := defaultConverter.SnakeToCamel("vlan_id"); name != "vlanID" {
+		t.Errorf("SnakeToCamel(vlan_id) = %s, want the heuristic vlanID", name)
+	}
+}
+
+func TestConversionErrorPaths(t *testing.T) {
+	type model struct {
+		Labels types.Map    `tfsdk:"labels"`
+		Rules  types.List   `tfsdk:"rules"`
+		Status types.Object `tfsdk:"status"`
+	}
+	ruleType := types.ObjectType{AttrTypes: map[string]attr.Type{"api_groups": types.ListType{ElemType: types.StringType}}}
+	statusTypes := map[string]attr.Type{"failed_login_since_successful_login": types.Int64Type}
+	newModel := func() model {
+		return model{
+			Labels: types.MapNull(types.StringType),
+			Rules:  types.ListNull(ruleType),
+			Status: types.ObjectNull(statusTypes),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		resp    map[string]any
+		path    path.Path
+		pointer string
+	}{
+		{
+			name:    "nested attribute",
+			resp:    map[string]any{"status": map[string]any{"failedLoginSinceSuccessfulLogin": "three"}},
+			path:    path.Root("status").AtName("failed_login_since_successful_login"),
+			pointer: "/status/failedLoginSinceSuccessfulLogin",
+		},
+		{
+			name:    "list element",
+			resp:    map[string]any{"rules": []any{map[string]any{"apiGroups": []any{"core"}}, map[string]any{"apiGroups": map[string]any{}}}},
+			path:    path.Root("rules").AtListIndex(1).AtName("api_groups"),
+			pointer: "/rules/1/apiGroups",
+		},
+		{
+			name:    "map key",
+			resp:    map[string]any{"labels": map[string]any{"app/name": 1}},
+			path:    path.Root("labels").AtMapKey("app/name"),
+			pointer: "/labels/app~1name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel()
+			err := AnyMapToModel(context.Background(), tt.resp, &m)
+			var convErr *ConversionError
+			if !errors.As(err, &convErr) {
+				t.Fatalf("AnyMapToModel(%v) = %v, want a ConversionError", tt.resp, err)
+			}
+			if !convErr.Path.Equal(tt.path) || convErr.Pointer != tt.pointer {
+				t.Errorf("AnyMapToModel(%v) failed at %s %s, want %s %s", tt.resp, convErr.Path, convErr.Pointer, tt.path, tt.pointer)
+			}
+		})
+	}
+}
+
+func TestLenientDecoding(t *testing.T) {
+	type model struct {
+		Extra  types.Dynamic `tfsdk:"extra"`
+		Name   types.String  `tfsdk:"name"`
+		Ports  types.List    `tfsdk:"ports"`
+		Status types.Object  `tfsdk:"status"`
+	}
+	statusTypes := map[string]attr.Type{
+		"extra": types.DynamicType,
+		"ready": types.BoolType,
+	}
+	newModel := func() model {
+		return model{
+			Extra:  types.DynamicNull(),
+			Name:   types.StringNull(),
+			Ports:  types.ListNull(types.Int64Type),
+			Status: types.ObjectNull(statusTypes),
+		}
+	}
+	resp := map[string]any{
+		"name":   "leaf1",
+		"ports":  []any{json.Number("1"), "two"},
+		"status": map[string]any{"ready": "yes", "phase": "Running"},
+		"uptime": json.Number("42"),
+	}
+	ctx := context.Background()
+
+	m := newModel()
+	err := AnyMapToModel(ctx, resp, &m)
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || !convErr.Path.Equal(path.Root("ports").AtListIndex(1)) {
+		t.Fatalf("strict AnyMapToModel() = %v, want a ConversionError at ports[1]", err)
+	}
+
+	m = newModel()
+	err = defaultConverter.Lenient().AnyMapToModel(ctx, resp, &m)
+	var warnings *ConversionWarnings
+	if !errors.As(err, &warnings) {
+		t.Fatalf("lenient AnyMapToModel() = %v, want ConversionWarnings", err)
+	}
+	wantPaths := []path.Path{path.Root("ports").AtListIndex(1), path.Root("status").AtName("ready")}
+	if len(warnings.Warnings) != len(wantPaths) {
+		t.Fatalf("lenient AnyMapToModel() warned %v, want warnings at %v", warnings, wantPaths)
+	}
+	for _, want := range wantPaths {
+		if !slices.ContainsFunc(warnings.Warnings, func(w *ConversionError) bool { return w.Path.Equal(want) }) {
+			t.Errorf("lenient AnyMapToModel() warned %v, want a warning at %s", warnings, want)
+		}
+	}
+
+	if m.Name.ValueString() != "leaf1" {
+		t.Errorf("name = %s, want leaf1", m.Name)
+	}
+	wantPorts := types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Null()})
+	if !m.Ports.Equal(wantPorts) {
+		t.Errorf("ports = %s, want %s", m.Ports, wantPorts)
+	}
+	extra, err := DynamicToAny(ctx, m.Extra)
+	if err != nil || !reflect.DeepEqual(extra, map[string]any{"uptime": int64(42)}) {
+		t.Errorf("extra = %v (%v), want the uptime key", extra, err)
+	}
+	statusAttrs := m.Status.Attributes()
+	if !statusAttrs["ready"].IsNull() {
+		t.Errorf("status.ready = %s, want null", statusAttrs["ready"])
+	}
+	statusExtra, err := DynamicToAny(ctx, statusAttrs["extra"])
+	if err != nil || !reflect.DeepEqual(statusExtra, map[string]any{"phase": "Running"}) {
+		t.Errorf("status.extra = %v (%v), want the phase key", statusExtra, err)
+	}
+
+	var diags diag.Diagnostics
+	if AddConversionError(&diags, "Failed to build response from API result", nil) {
+		t.Errorf("AddConversionError(nil) reported an error")
+	}
+	if AddConversionError(&diags, "Failed to build response from API result", warnings) || diags.WarningsCount() != 2 {
+		t.Errorf("AddConversionError(%v) = %v, want 2 warnings", warnings, diags)
+	}
+}
+
+func TestItemExtraAttribute(t *testing.T) {
+	s := AddItemExtraAttribute(schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"items": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{Computed: true},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}, "items")
+	ctx := context.Background()
+	type model struct {
+		Items types.Set `tfsdk:"items"`
+	}
+	m := model{Items: types.SetNull(s.Attributes["items"].GetType().(types.SetType).ElemType)}
+
+	resp := map[string]any{
+		"items": []any{
+			map[string]any{"name": "leaf1", "zone": "a", "rack": json.Number("3")},
+			map[string]any{"name": "leaf2"},
+		},
+	}
+	if err := AnyMapToModel(ctx, resp, &m); err != nil {
+		t.Fatalf("AnyMapToModel() error = %v", err)
+	}
+	extras := map[string]attr.Value{}
+	for _, elem := range m.Items.Elements() {
+		attrs := elem.(types.Object).Attributes()
+		extras[attrs["name"].(types.String).ValueString()] = attrs["extra"]
+	}
+	if want := types.StringValue(`{"rack":3,"zone":"a"}`); !extras["leaf1"].Equal(want) {
+		t.Errorf("leaf1 extra = %s, want %s", extras["leaf1"], want)
+	}
+	if !extras["leaf2"].IsNull() {
+		t.Errorf("leaf2 extra = %s, want null", extras["leaf2"])
+	}
+}
+
+func TestExtraValue(t *testing.T) {
+	type model struct {
+		Name types.String `tfsdk:"name"`
+	}
+	m := model{Name: types.StringValue("leaf1")}
+
+	extra, err := defaultConverter.ExtraValue(map[string]any{"name": "leaf1", "zone": "a"}, &m)
+	if err != nil {
+		t.Fatalf("ExtraValue() error = %v", err)
+	}
+	got, err := DynamicToAny(context.Background(), extra)
+	if err != nil || !reflect.DeepEqual(got, map[string]any{"zone": "a"}) {
+		t.Errorf("ExtraValue() = %v (%v), want the zone key", got, err)
+	}
+
+	extra, err = defaultConverter.ExtraValue(map[string]any{"name": "leaf1"}, &m)
+	if err != nil || !extra.IsNull() {
+		t.Errorf("ExtraValue() = %s (%v), want null", extra, err)
+	}
+}